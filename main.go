@@ -37,6 +37,51 @@ func runFile(file string) {
 	for _, val := range vals {
 		fmt.Println(val.ValueToString())
 	}
+
+	taskErrors := snow.UnobservedTaskErrors()
+	for _, err := range taskErrors {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	if len(taskErrors) != 0 {
+		os.Exit(1)
+	}
+}
+
+func checkFile(file string) {
+	code, err := os.ReadFile(file)
+	if err != nil {
+		panic(err)
+	}
+
+	f := snow.NewFile(file, string(code))
+
+	t, errors := snow.NewLexer(f).Tokenize()
+	if len(errors) != 0 {
+		logErrors(errors)
+		os.Exit(1)
+	}
+
+	p := snow.NewParser(t, f)
+
+	s, err := p.Parse()
+
+	for _, warning := range p.Warnings() {
+		fmt.Println(warning.Warning())
+	}
+
+	if err != nil {
+		logErrors([]error{err})
+		os.Exit(1)
+	}
+
+	errors = snow.NewChecker(f).Check(s)
+	if len(errors) != 0 {
+		logErrors(errors)
+		os.Exit(1)
+	}
+
+	fmt.Printf("No type errors found in '%s'\n", file)
 }
 
 func runRepl() {
@@ -98,6 +143,10 @@ func run(filename string, code string, e *snow.Environment) ([]snow.RTValue, []e
 
 	s, err2 := p.Parse()
 
+	for _, warning := range p.Warnings() {
+		fmt.Println(warning.Warning())
+	}
+
 	if err2 != nil {
 		var errArray = []error{err2}
 		return nil, errArray
@@ -122,7 +171,9 @@ func run(filename string, code string, e *snow.Environment) ([]snow.RTValue, []e
 }
 
 func main() {
-	if len(os.Args) == 2 {
+	if len(os.Args) == 3 && os.Args[1] == "check" {
+		checkFile(os.Args[2])
+	} else if len(os.Args) == 2 {
 		runFile(os.Args[1])
 	} else {
 		runRepl()
@@ -2,13 +2,14 @@ package snow
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 )
 
 type RTError struct {
 	SnowError
 	environment *Environment
+	value       RTValue
+	located     bool
 }
 
 func (rTError RTError) Error() string {
@@ -17,6 +18,13 @@ func (rTError RTError) Error() string {
 		tip = tip + "\n"
 	}
 
+	stack := rTError.Stack()
+
+	codeAtLine := rTError.Pos.File.Line(rTError.Pos.Start.Ln)
+	return fmt.Sprintf("Stack with most recent last:\n%s\n\033[31m%s\033[0m: %s\n%s%d | %s\n%s", strings.Join(stack, "\n"), rTError.ErrType, rTError.Msg, tip, rTError.Pos.Start.Ln, codeAtLine, rTError.arrows(codeAtLine))
+}
+
+func (rTError RTError) Stack() []string {
 	stack := make([]string, 0)
 	env := rTError.environment
 	for env != nil {
@@ -30,17 +38,19 @@ func (rTError RTError) Error() string {
 		} else {
 			stack = append(stack, fmt.Sprintf("In %s starting at line %d in file '%s'", name, env.StartLine, env.FileName))
 		}
-		env = env.Parent
+
+		if env.Caller != nil {
+			env = env.Caller
+		} else {
+			env = env.Parent
+		}
 	}
 
 	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
 		stack[i], stack[j] = stack[j], stack[i]
 	}
 
-	codeAtLine := strings.ReplaceAll(strings.Split(rTError.Pos.File.Code, "\n")[rTError.Pos.Start.Ln-1], "\t", "   ")
-	add := len(strconv.Itoa(rTError.Pos.Start.Ln+1)) + 3
-	arrows := strings.Repeat(" ", rTError.Pos.Start.Col+add) + strings.Repeat("^", rTError.Pos.End.Col-rTError.Pos.Start.Col+1)
-	return fmt.Sprintf("Stack with most recent last:\n%s\n\033[31m%s\033[0m: %s\n%s%d | %s\n%s", strings.Join(stack, "\n"), rTError.ErrType, rTError.Msg, tip, rTError.Pos.Start.Ln, codeAtLine, arrows)
+	return stack
 }
 
 func NewRuntimeError(errType SnowErrType, msg string, tip string, pos SEPos, env *Environment) *RTError {
@@ -55,8 +65,7 @@ func NewRuntimeError(errType SnowErrType, msg string, tip string, pos SEPos, env
 	}
 }
 
-func NewValueRTError(opTType TokenType, x RTValue, y RTValue, pos SEPos, env *Environment) *RTError {
-	var msg string
+func valueErrorOperation(opTType TokenType) (string, string) {
 	var op string
 	var withBy string
 
@@ -73,6 +82,32 @@ func NewValueRTError(opTType TokenType, x RTValue, y RTValue, pos SEPos, env *En
 	case SLASH:
 		op = "divide"
 		withBy = "by"
+	case PERCENT:
+		op = "take the remainder of"
+		withBy = "divided by"
+	case DOUBLE_STAR:
+		op = "raise"
+		withBy = "to the power of"
+	case DOUBLE_SLASH:
+		op = "floor divide"
+		withBy = "by"
+	case AMPERSAND:
+		op = "apply bitwise and between"
+		withBy = "and"
+	case PIPE:
+		op = "apply bitwise or between"
+		withBy = "and"
+	case CARET:
+		op = "apply bitwise xor between"
+		withBy = "and"
+	case LEFT_SHIFT:
+		op = "shift left"
+		withBy = "by"
+	case RIGHT_SHIFT:
+		op = "shift right"
+		withBy = "by"
+	case TILDE:
+		op = "apply bitwise not to"
 	case EQUALS:
 		op = "check equality between"
 		withBy = "and"
@@ -93,6 +128,13 @@ func NewValueRTError(opTType TokenType, x RTValue, y RTValue, pos SEPos, env *En
 		withBy = "and"
 	}
 
+	return op, withBy
+}
+
+func NewValueRTError(opTType TokenType, x RTValue, y RTValue, pos SEPos, env *Environment) *RTError {
+	var msg string
+	op, withBy := valueErrorOperation(opTType)
+
 	if y != nil {
 		msg = fmt.Sprintf("unable to %s '%s' with value of '%s' %s '%s' with value of '%s'", op, x.GetType(), x.ValueToString(), withBy, y.GetType(), y.ValueToString())
 	} else {
@@ -113,7 +155,7 @@ func NewValueRTError(opTType TokenType, x RTValue, y RTValue, pos SEPos, env *En
 func NewDivisionByZeroRTError(x RTValue, y RTValue, pos SEPos, env *Environment) *RTError {
 	return &RTError{
 		SnowError: *NewSnowError(
-			VALUE_ERROR,
+			DIVISION_BY_ZERO_ERROR,
 			fmt.Sprintf("unable to divide '%s' with value of '%s' by '%s' with value of '%s'", x.GetType(), x.ValueToString(), y.GetType(), y.ValueToString()),
 			"",
 			pos,
@@ -122,6 +164,42 @@ func NewDivisionByZeroRTError(x RTValue, y RTValue, pos SEPos, env *Environment)
 	}
 }
 
+func NewTooBigValueRTError(x RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			TOO_BIG_VALUE_ERROR,
+			fmt.Sprintf("the value '%s' of type '%s' is too big to be used here", x.ValueToString(), x.GetType()),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewDestructuringRTError(msg string, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			DESTRUCTURING_ERROR,
+			msg,
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewNegativeShiftCountRTError(x RTValue, y RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			VALUE_ERROR,
+			fmt.Sprintf("unable to shift '%s' with value of '%s' by a negative count of '%s'", x.GetType(), x.ValueToString(), y.ValueToString()),
+			"The shift count must be zero or greater",
+			pos,
+		),
+		environment: env,
+	}
+}
+
 func NewInvalidAttributeRTError(x RTValue, y Token, pos SEPos, env *Environment) *RTError {
 	return &RTError{
 		SnowError: *NewSnowError(
@@ -158,11 +236,11 @@ func NewInvalidCallRTError(x RTValue, pos SEPos, env *Environment) *RTError {
 	}
 }
 
-func NewTooManyArgumentsRTError(x RTValue, expected int, got int, pos SEPos, env *Environment) *RTError {
+func NewTooManyArgumentsRTError(x RTValue, expected int, got int, unexpected RTValue, pos SEPos, env *Environment) *RTError {
 	return &RTError{
 		SnowError: *NewSnowError(
 			ARGUMENT_ERROR,
-			fmt.Sprintf("too may arguments, object of type '%s' expected %d arguments but got %d arguments", x.GetType(), expected, got),
+			fmt.Sprintf("too many arguments, object of type '%s' expected %d arguments but got %d arguments, the argument with value of '%s' is unexpected", x.GetType(), expected, got, unexpected.ValueToString()),
 			"",
 			pos,
 		),
@@ -170,14 +248,135 @@ func NewTooManyArgumentsRTError(x RTValue, expected int, got int, pos SEPos, env
 	}
 }
 
-func NewTooFewArgumentsRTError(x RTValue, expected int, got int, pos SEPos, env *Environment) *RTError {
+func NewTooFewArgumentsRTError(x RTValue, expected int, got int, missing string, pos SEPos, env *Environment) *RTError {
 	return &RTError{
 		SnowError: *NewSnowError(
 			ARGUMENT_ERROR,
-			fmt.Sprintf("too few arguments, object of type '%s' expected %d arguments but got %d arguments", x.GetType(), expected, got),
+			fmt.Sprintf("too few arguments, object of type '%s' expected %d arguments but got %d arguments, the parameter '%s' is missing a value", x.GetType(), expected, got, missing),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewUnexpectedNamedArgumentRTError(x RTValue, name string, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			ARGUMENT_ERROR,
+			fmt.Sprintf("object of type '%s' has no parameter called '%s'", x.GetType(), name),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewDuplicateArgumentRTError(x RTValue, name string, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			ARGUMENT_ERROR,
+			fmt.Sprintf("object of type '%s' got more than one value for the parameter '%s'", x.GetType(), name),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewNotIndexableRTError(x RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			NOT_INDEXABLE_ERROR,
+			fmt.Sprintf("object of type '%s' can not be indexed", x.GetType()),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewInvalidIndexRTError(x RTValue, index RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			INVALID_INDEX_ERROR,
+			fmt.Sprintf("unable to index object of type '%s' with '%s' with value of '%s'", x.GetType(), index.GetType(), index.ValueToString()),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewIndexOutOfRangeRTError(x RTValue, index int, length int, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			INDEX_OUT_OF_RANGE_ERROR,
+			fmt.Sprintf("index %d is out of range for object of type '%s' with a length of %d", index, x.GetType(), length),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewNotSliceableRTError(x RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			NOT_SLICEABLE_ERROR,
+			fmt.Sprintf("object of type '%s' can not be sliced", x.GetType()),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewUnhashableRTError(x RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			UNHASHABLE_ERROR,
+			fmt.Sprintf("object of type '%s' can not be used as a key", x.GetType()),
+			"Only values of type 'INT', 'FLOAT', 'BOOL', 'STRING' and 'TUPLE' can be used as keys",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewKeyRTError(x RTValue, key RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			KEY_ERROR,
+			fmt.Sprintf("object of type '%s' has no key '%s' with value of '%s'", x.GetType(), key.GetType(), key.ValueToString()),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewNotIterableRTError(x RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			NOT_ITERABLE_ERROR,
+			fmt.Sprintf("object of type '%s' can not be iterated over", x.GetType()),
+			"",
+			pos,
+		),
+		environment: env,
+	}
+}
+
+func NewThrownRTError(value RTValue, pos SEPos, env *Environment) *RTError {
+	return &RTError{
+		SnowError: *NewSnowError(
+			THROWN_ERROR,
+			value.ValueToString(),
 			"",
 			pos,
 		),
 		environment: env,
+		value:       value,
 	}
 }
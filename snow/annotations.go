@@ -0,0 +1,33 @@
+package snow
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TypeAnnotation struct {
+	Names    []Token
+	Optional bool
+	Pos      SEPos
+}
+
+func NewTypeAnnotation(names []Token, optional bool, pos SEPos) *TypeAnnotation {
+	return &TypeAnnotation{
+		Names:    names,
+		Optional: optional,
+		Pos:      pos,
+	}
+}
+
+func (typeAnnotation TypeAnnotation) ToString() string {
+	names := make([]string, 0, len(typeAnnotation.Names))
+	for _, name := range typeAnnotation.Names {
+		names = append(names, name.Value)
+	}
+
+	if typeAnnotation.Optional {
+		return fmt.Sprintf("(TYPE: %s?)", strings.Join(names, " | "))
+	}
+
+	return fmt.Sprintf("(TYPE: %s)", strings.Join(names, " | "))
+}
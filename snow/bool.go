@@ -50,6 +50,26 @@ func (rTBool *RTBool) SetAttribute(other string, value RTValue, position SEPos)
 	return nil, NewUnableToAssignAttributeRTError(rTBool, other, value, position, rTBool.Environment)
 }
 
+func (rTBool *RTBool) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTBool, position, rTBool.Environment)
+}
+
+func (rTBool *RTBool) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTBool, position, rTBool.Environment)
+}
+
+func (rTBool *RTBool) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTBool, position, rTBool.Environment)
+}
+
+func (rTBool *RTBool) Hash(position SEPos) (string, error) {
+	return fmt.Sprintf("b:%t", rTBool.Value), nil
+}
+
+func (rTBool *RTBool) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTBool, position, rTBool.Environment)
+}
+
 func (rTBool *RTBool) Add(other RTValue, position SEPos) (RTValue, error) {
 	return nil, NewValueRTError(
 		PLUS,
@@ -90,6 +110,101 @@ func (rTBool *RTBool) Divide(other RTValue, position SEPos) (RTValue, error) {
 	)
 }
 
+func (rTBool *RTBool) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_BOOL:
+		return NewRTBool(position, rTBool.Value && other.GetValue().(bool), rTBool.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_BOOL:
+		return NewRTBool(position, rTBool.Value || other.GetValue().(bool), rTBool.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PIPE,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_BOOL:
+		return NewRTBool(position, rTBool.Value != other.GetValue().(bool), rTBool.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		CARET,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
+func (rTBool *RTBool) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTBool,
+		other,
+		position,
+		rTBool.Environment,
+	)
+}
+
 func (rTBool *RTBool) Equals(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_BOOL:
@@ -152,10 +267,20 @@ func (rTBool *RTBool) Not(position SEPos) (RTValue, error) {
 	return NewRTBool(position, !rTBool.Value, rTBool.Environment), nil
 }
 
+func (rTBool *RTBool) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTBool,
+		nil,
+		position,
+		rTBool.Environment,
+	)
+}
+
 func (rTBool *RTBool) ToBool(position SEPos) (RTValue, error) {
 	return NewRTBool(position, rTBool.Value, rTBool.Environment), nil
 }
 
-func (rTBool *RTBool) Call(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+func (rTBool *RTBool) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
 	return nil, NewInvalidCallRTError(rTBool, position, rTBool.Environment)
 }
@@ -0,0 +1,302 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type BuiltinFunc func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error)
+
+type RTBuiltinFunction struct {
+	Name        string
+	Parameters  []string
+	Variadic    bool
+	Function    BuiltinFunc
+	Pos         SEPos
+	Environment *Environment
+}
+
+func NewRTBuiltinFunction(name string, parameters []string, function BuiltinFunc, pos SEPos, env *Environment) *RTBuiltinFunction {
+	return &RTBuiltinFunction{
+		Name:        name,
+		Parameters:  parameters,
+		Function:    function,
+		Pos:         pos,
+		Environment: env,
+	}
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) ToString() string {
+	return fmt.Sprintf("(BUILTIN_FUNCTION: %s)", rTBuiltinFunction.Name)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) ValueToString() string {
+	return fmt.Sprintf("BUILTIN_FUNCTION %s", rTBuiltinFunction.Name)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) GetType() RTType {
+	return RTT_BUILTIN_FUNCTION
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) GetValue() interface{} {
+	return fmt.Sprintf("BUILTIN_FUNCTION %s", rTBuiltinFunction.Name)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) GetEnvironment() *Environment {
+	return rTBuiltinFunction.Environment
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Dot(other Token, position SEPos) (RTValue, error) {
+	return nil, NewInvalidAttributeRTError(rTBuiltinFunction, other, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTBuiltinFunction, other, value, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTBuiltinFunction, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTBuiltinFunction, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTBuiltinFunction, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTBuiltinFunction, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTBuiltinFunction, position, rTBuiltinFunction.Environment)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTBuiltinFunction), rTBuiltinFunction.Environment), nil
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTBuiltinFunction), rTBuiltinFunction.Environment), nil
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTBuiltinFunction,
+		other,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTBuiltinFunction.Environment), nil
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTBuiltinFunction,
+		nil,
+		position,
+		rTBuiltinFunction.Environment,
+	)
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTBuiltinFunction.Environment), nil
+}
+
+func (rTBuiltinFunction *RTBuiltinFunction) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	if rTBuiltinFunction.Variadic {
+		if len(namedArguments) != 0 {
+			return nil, NewUnexpectedNamedArgumentRTError(rTBuiltinFunction, namedArguments[0].Name, namedArguments[0].Pos, interpreter.currentEnv())
+		}
+
+		return rTBuiltinFunction.Function(arguments, position, interpreter)
+	}
+
+	if len(arguments) > len(rTBuiltinFunction.Parameters) {
+		return nil, NewTooManyArgumentsRTError(rTBuiltinFunction, len(rTBuiltinFunction.Parameters), len(arguments), arguments[len(rTBuiltinFunction.Parameters)], position, interpreter.currentEnv())
+	}
+
+	values := make([]RTValue, len(rTBuiltinFunction.Parameters))
+	copy(values, arguments)
+
+	for _, named := range namedArguments {
+		index := -1
+		for i, parameter := range rTBuiltinFunction.Parameters {
+			if parameter == named.Name {
+				index = i
+			}
+		}
+
+		if index == -1 {
+			return nil, NewUnexpectedNamedArgumentRTError(rTBuiltinFunction, named.Name, named.Pos, interpreter.currentEnv())
+		} else if values[index] != nil {
+			return nil, NewDuplicateArgumentRTError(rTBuiltinFunction, named.Name, named.Pos, interpreter.currentEnv())
+		}
+
+		values[index] = named.Value
+	}
+
+	for index, value := range values {
+		if value == nil {
+			return nil, NewTooFewArgumentsRTError(rTBuiltinFunction, len(rTBuiltinFunction.Parameters), len(arguments)+len(namedArguments), rTBuiltinFunction.Parameters[index], position, interpreter.currentEnv())
+		}
+	}
+
+	return rTBuiltinFunction.Function(values, position, interpreter)
+}
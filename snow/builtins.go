@@ -0,0 +1,154 @@
+package snow
+
+import (
+	"fmt"
+)
+
+var builtins map[string]RTValue
+
+func init() {
+	builtins = map[string]RTValue{
+		"range": newVariadicBuiltin("range", []string{"start", "end", "step"}, builtinRange),
+		"next":  newVariadicBuiltin("next", []string{"generator", "default"}, builtinNext),
+		"chan":  newVariadicBuiltin("chan", []string{"size"}, builtinChan),
+	}
+
+	for name, errType := range RuntimeErrTypes {
+		builtins[name] = NewRTString(SEPos{}, string(errType), nil)
+	}
+}
+
+func newVariadicBuiltin(name string, parameters []string, function BuiltinFunc) *RTBuiltinFunction {
+	builtin := NewRTBuiltinFunction(name, parameters, function, SEPos{}, nil)
+	builtin.Variadic = true
+
+	return builtin
+}
+
+func builtinRange(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	if len(arguments) == 0 {
+		return nil, NewTooFewArgumentsRTError(builtins["range"], 1, 0, "end", position, interpreter.currentEnv())
+	} else if len(arguments) > 3 {
+		return nil, NewTooManyArgumentsRTError(builtins["range"], 3, len(arguments), arguments[3], position, interpreter.currentEnv())
+	}
+
+	values := make([]int, 0, len(arguments))
+	for _, argument := range arguments {
+		if argument.GetType() != RTT_INT {
+			return nil, NewRuntimeError(
+				ARGUMENT_ERROR,
+				fmt.Sprintf("range expected arguments of type '%s' but got '%s' with value of '%s'", RTT_INT, argument.GetType(), argument.ValueToString()),
+				"",
+				position,
+				interpreter.currentEnv(),
+			)
+		}
+
+		value, err := toInt(argument, position, interpreter.currentEnv())
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	start, end, step := 0, 0, 1
+	switch len(values) {
+	case 1:
+		end = values[0]
+	case 2:
+		start, end = values[0], values[1]
+	case 3:
+		start, end, step = values[0], values[1], values[2]
+	}
+
+	if step == 0 {
+		return nil, NewRuntimeError(
+			ARGUMENT_ERROR,
+			"the step of a range can not be zero",
+			"",
+			position,
+			interpreter.currentEnv(),
+		)
+	}
+
+	return NewRTRange(position, start, end, step, interpreter.currentEnv()), nil
+}
+
+func builtinNext(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	if len(arguments) == 0 {
+		return nil, NewTooFewArgumentsRTError(builtins["next"], 1, 0, "generator", position, interpreter.currentEnv())
+	} else if len(arguments) > 2 {
+		return nil, NewTooManyArgumentsRTError(builtins["next"], 2, len(arguments), arguments[2], position, interpreter.currentEnv())
+	}
+
+	generator, ok := arguments[0].(*RTGenerator)
+	if !ok {
+		return nil, NewRuntimeError(
+			ARGUMENT_ERROR,
+			fmt.Sprintf("next expected an argument of type '%s' but got '%s' with value of '%s'", RTT_GENERATOR, arguments[0].GetType(), arguments[0].ValueToString()),
+			"",
+			position,
+			interpreter.currentEnv(),
+		)
+	}
+
+	value, ok, err := generator.Next(position, interpreter)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		if len(arguments) == 2 {
+			return arguments[1], nil
+		}
+
+		return nil, NewRuntimeError(
+			GENERATOR_EXHAUSTED_ERROR,
+			fmt.Sprintf("the generator '%s' has no more values", generator.Function.Name),
+			"Pass a default value to next to use when the generator has no more values",
+			position,
+			interpreter.currentEnv(),
+		)
+	}
+
+	return value, nil
+}
+
+func builtinChan(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	if len(arguments) > 1 {
+		return nil, NewTooManyArgumentsRTError(builtins["chan"], 1, len(arguments), arguments[1], position, interpreter.currentEnv())
+	}
+
+	size := 0
+	if len(arguments) == 1 {
+		if arguments[0].GetType() != RTT_INT {
+			return nil, NewRuntimeError(
+				ARGUMENT_ERROR,
+				fmt.Sprintf("chan expected an argument of type '%s' but got '%s' with value of '%s'", RTT_INT, arguments[0].GetType(), arguments[0].ValueToString()),
+				"",
+				position,
+				interpreter.currentEnv(),
+			)
+		}
+
+		value, err := toInt(arguments[0], position, interpreter.currentEnv())
+		if err != nil {
+			return nil, err
+		}
+
+		if value < 0 {
+			return nil, NewRuntimeError(
+				ARGUMENT_ERROR,
+				fmt.Sprintf("chan expected a size of at least 0 but got %d", value),
+				"",
+				position,
+				interpreter.currentEnv(),
+			)
+		}
+
+		size = value
+	}
+
+	return NewRTChannel(size, position, interpreter.currentEnv()), nil
+}
@@ -0,0 +1,550 @@
+package snow
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+)
+
+type channelWaiter struct {
+	ready    chan struct{}
+	index    int
+	value    RTValue
+	ok       bool
+	closed   bool
+	deadlock bool
+}
+
+type channelCase struct {
+	channel *RTChannel
+	send    bool
+	value   RTValue
+	waiter  *channelWaiter
+	index   int
+}
+
+var scheduler = struct {
+	mutex   sync.Mutex
+	active  int
+	blocked map[*channelWaiter]bool
+	failed  []*RTTask
+}{
+	active:  1,
+	blocked: make(map[*channelWaiter]bool, 0),
+}
+
+func newChannelWaiter() *channelWaiter {
+	return &channelWaiter{ready: make(chan struct{})}
+}
+
+func (waiter *channelWaiter) fire(index int, value RTValue, ok bool) bool {
+	if !scheduler.blocked[waiter] {
+		return false
+	}
+
+	delete(scheduler.blocked, waiter)
+	scheduler.active++
+
+	waiter.index, waiter.value, waiter.ok = index, value, ok
+	close(waiter.ready)
+
+	return true
+}
+
+func (waiter *channelWaiter) wait(position SEPos, env *Environment) error {
+	scheduler.blocked[waiter] = true
+	scheduler.active--
+	checkDeadlock()
+
+	scheduler.mutex.Unlock()
+
+	<-waiter.ready
+
+	if waiter.deadlock {
+		return NewRuntimeError(
+			CHANNEL_ERROR,
+			"every task is waiting, so this would wait forever",
+			"Make sure every recv has a matching send, or close the channel when nothing more will be sent",
+			position,
+			env,
+		)
+	} else if waiter.closed {
+		return newClosedChannelRTError(position, env)
+	}
+
+	return nil
+}
+
+func checkDeadlock() {
+	if scheduler.active > 0 {
+		return
+	}
+
+	for waiter := range scheduler.blocked {
+		waiter.deadlock = true
+		waiter.fire(-1, nil, false)
+	}
+}
+
+func startTask() {
+	scheduler.mutex.Lock()
+	scheduler.active++
+	scheduler.mutex.Unlock()
+}
+
+type RTChannel struct {
+	Pos         SEPos
+	Environment *Environment
+	size        int
+	buffer      []RTValue
+	closed      bool
+	receivers   []channelCase
+	senders     []channelCase
+}
+
+func NewRTChannel(size int, pos SEPos, env *Environment) *RTChannel {
+	return &RTChannel{
+		Pos:         pos,
+		Environment: env,
+		size:        size,
+		buffer:      make([]RTValue, 0, size),
+	}
+}
+
+func newClosedChannelRTError(position SEPos, env *Environment) error {
+	return NewRuntimeError(
+		CHANNEL_ERROR,
+		"the channel is already closed",
+		"Only close a channel once, and do not send values after closing it",
+		position,
+		env,
+	)
+}
+
+func (rTChannel *RTChannel) trySend(value RTValue) (bool, bool) {
+	if rTChannel.closed {
+		return false, true
+	}
+
+	for len(rTChannel.receivers) != 0 {
+		receiver := rTChannel.receivers[0]
+		rTChannel.receivers = rTChannel.receivers[1:]
+
+		if receiver.waiter.fire(receiver.index, value, true) {
+			return true, false
+		}
+	}
+
+	if len(rTChannel.buffer) < rTChannel.size {
+		rTChannel.buffer = append(rTChannel.buffer, value)
+
+		return true, false
+	}
+
+	return false, false
+}
+
+func (rTChannel *RTChannel) tryRecv() (RTValue, bool, bool) {
+	if len(rTChannel.buffer) != 0 {
+		value := rTChannel.buffer[0]
+		rTChannel.buffer = rTChannel.buffer[1:]
+
+		for len(rTChannel.senders) != 0 {
+			sender := rTChannel.senders[0]
+			rTChannel.senders = rTChannel.senders[1:]
+
+			if sender.waiter.fire(sender.index, nil, true) {
+				rTChannel.buffer = append(rTChannel.buffer, sender.value)
+
+				break
+			}
+		}
+
+		return value, true, true
+	}
+
+	for len(rTChannel.senders) != 0 {
+		sender := rTChannel.senders[0]
+		rTChannel.senders = rTChannel.senders[1:]
+
+		if sender.waiter.fire(sender.index, nil, true) {
+			return sender.value, true, true
+		}
+	}
+
+	if rTChannel.closed {
+		return nil, false, true
+	}
+
+	return nil, false, false
+}
+
+func selectChannels(cases []channelCase, block bool, position SEPos, env *Environment) (int, RTValue, bool, error) {
+	scheduler.mutex.Lock()
+
+	offset := 0
+	if len(cases) > 1 {
+		offset = rand.Intn(len(cases))
+	}
+
+	for i := range cases {
+		index := (offset + i) % len(cases)
+		selectCase := cases[index]
+
+		if selectCase.send {
+			sent, closed := selectCase.channel.trySend(selectCase.value)
+			if closed {
+				scheduler.mutex.Unlock()
+
+				return index, nil, false, newClosedChannelRTError(position, env)
+			} else if sent {
+				scheduler.mutex.Unlock()
+
+				return index, nil, true, nil
+			}
+		} else if value, ok, ready := selectCase.channel.tryRecv(); ready {
+			scheduler.mutex.Unlock()
+
+			return index, value, ok, nil
+		}
+	}
+
+	if !block {
+		scheduler.mutex.Unlock()
+
+		return -1, nil, false, nil
+	}
+
+	waiter := newChannelWaiter()
+	for index, selectCase := range cases {
+		selectCase.waiter, selectCase.index = waiter, index
+
+		if selectCase.send {
+			selectCase.channel.senders = append(selectCase.channel.senders, selectCase)
+		} else {
+			selectCase.channel.receivers = append(selectCase.channel.receivers, selectCase)
+		}
+	}
+
+	err := waiter.wait(position, env)
+	if err != nil {
+		return -1, nil, false, err
+	}
+
+	return waiter.index, waiter.value, waiter.ok, nil
+}
+
+func (rTChannel *RTChannel) Send(value RTValue, position SEPos, env *Environment) error {
+	_, _, _, err := selectChannels([]channelCase{{channel: rTChannel, send: true, value: value}}, true, position, env)
+
+	return err
+}
+
+func (rTChannel *RTChannel) Recv(position SEPos, env *Environment) (RTValue, bool, error) {
+	_, value, ok, err := selectChannels([]channelCase{{channel: rTChannel}}, true, position, env)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !ok {
+		return NewRTNull(), false, nil
+	}
+
+	return value, true, nil
+}
+
+func (rTChannel *RTChannel) Close(position SEPos, env *Environment) error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	if rTChannel.closed {
+		return newClosedChannelRTError(position, env)
+	}
+
+	rTChannel.closed = true
+
+	for _, receiver := range rTChannel.receivers {
+		receiver.waiter.fire(receiver.index, nil, false)
+	}
+
+	for _, sender := range rTChannel.senders {
+		if scheduler.blocked[sender.waiter] {
+			sender.waiter.closed = true
+			sender.waiter.fire(sender.index, nil, false)
+		}
+	}
+
+	rTChannel.receivers, rTChannel.senders = nil, nil
+
+	return nil
+}
+
+func (rTChannel *RTChannel) ToString() string {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	return fmt.Sprintf("(CHANNEL: %d/%d)", len(rTChannel.buffer), rTChannel.size)
+}
+
+func (rTChannel *RTChannel) ValueToString() string {
+	return "CHANNEL"
+}
+
+func (rTChannel *RTChannel) GetType() RTType {
+	return RTT_CHANNEL
+}
+
+func (rTChannel *RTChannel) GetValue() interface{} {
+	return rTChannel
+}
+
+func (rTChannel *RTChannel) GetEnvironment() *Environment {
+	return rTChannel.Environment
+}
+
+func (rTChannel *RTChannel) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "send":
+		return NewRTBuiltinFunction("send", []string{"value"}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			err := rTChannel.Send(arguments[0], position, interpreter.currentEnv())
+			if err != nil {
+				return nil, err
+			}
+
+			return NewRTNull(), nil
+		}, position, rTChannel.Environment), nil
+	case "recv":
+		return NewRTBuiltinFunction("recv", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			value, _, err := rTChannel.Recv(position, interpreter.currentEnv())
+
+			return value, err
+		}, position, rTChannel.Environment), nil
+	case "close":
+		return NewRTBuiltinFunction("close", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			err := rTChannel.Close(position, interpreter.currentEnv())
+			if err != nil {
+				return nil, err
+			}
+
+			return NewRTNull(), nil
+		}, position, rTChannel.Environment), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTChannel, other, position, rTChannel.Environment)
+}
+
+func (rTChannel *RTChannel) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTChannel, other, value, position, rTChannel.Environment)
+}
+
+func (rTChannel *RTChannel) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTChannel, position, rTChannel.Environment)
+}
+
+func (rTChannel *RTChannel) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTChannel, position, rTChannel.Environment)
+}
+
+func (rTChannel *RTChannel) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTChannel, position, rTChannel.Environment)
+}
+
+func (rTChannel *RTChannel) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTChannel, position, rTChannel.Environment)
+}
+
+func (rTChannel *RTChannel) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return func() (RTValue, bool, error) {
+		return rTChannel.Recv(position, interpreter.currentEnv())
+	}, nil
+}
+
+func (rTChannel *RTChannel) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTChannel), rTChannel.Environment), nil
+}
+
+func (rTChannel *RTChannel) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTChannel), rTChannel.Environment), nil
+}
+
+func (rTChannel *RTChannel) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTChannel,
+		other,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTChannel.Environment), nil
+}
+
+func (rTChannel *RTChannel) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTChannel,
+		nil,
+		position,
+		rTChannel.Environment,
+	)
+}
+
+func (rTChannel *RTChannel) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTChannel.Environment), nil
+}
+
+func (rTChannel *RTChannel) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTChannel, position, rTChannel.Environment)
+}
@@ -0,0 +1,1105 @@
+package snow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type StaticType map[RTType]bool
+
+var staticTypeOrder = []RTType{
+	RTT_INT,
+	RTT_FLOAT,
+	RTT_BOOL,
+	RTT_STRING,
+	RTT_NULL,
+	RTT_LIST,
+	RTT_TUPLE,
+	RTT_MAP,
+	RTT_RANGE,
+	RTT_FUNCTION,
+	RTT_BUILTIN_FUNCTION,
+	RTT_CLASS,
+	RTT_INSTANCE,
+	RTT_ERROR,
+	RTT_MODULE,
+	RTT_GENERATOR,
+	RTT_TASK,
+	RTT_CHANNEL,
+}
+
+var annotationTypes = map[string][]RTType{
+	"int":       {RTT_INT},
+	"float":     {RTT_FLOAT},
+	"bool":      {RTT_BOOL},
+	"string":    {RTT_STRING},
+	"null":      {RTT_NULL},
+	"list":      {RTT_LIST},
+	"tuple":     {RTT_TUPLE},
+	"map":       {RTT_MAP},
+	"range":     {RTT_RANGE},
+	"function":  {RTT_FUNCTION, RTT_BUILTIN_FUNCTION},
+	"class":     {RTT_CLASS},
+	"error":     {RTT_ERROR},
+	"module":    {RTT_MODULE},
+	"generator": {RTT_GENERATOR},
+	"task":      {RTT_TASK},
+	"channel":   {RTT_CHANNEL},
+}
+
+func annotationTypesTip() string {
+	names := make([]string, 0, len(annotationTypes)+1)
+	for name := range annotationTypes {
+		names = append(names, fmt.Sprintf("'%s'", name))
+	}
+
+	sort.Strings(names)
+	names = append(names, "'any'")
+
+	return fmt.Sprintf("Types are %s or the name of a class", strings.Join(names, ", "))
+}
+
+func NewStaticType(types ...RTType) StaticType {
+	staticType := make(StaticType, len(types))
+	for _, rTType := range types {
+		staticType[rTType] = true
+	}
+
+	return staticType
+}
+
+func (staticType StaticType) Union(other StaticType) StaticType {
+	if staticType == nil || other == nil {
+		return nil
+	}
+
+	union := make(StaticType, len(staticType)+len(other))
+	for rTType := range staticType {
+		union[rTType] = true
+	}
+
+	for rTType := range other {
+		union[rTType] = true
+	}
+
+	return union
+}
+
+func (staticType StaticType) Accepts(other StaticType) bool {
+	if staticType == nil || other == nil {
+		return true
+	}
+
+	for rTType := range other {
+		if staticType[rTType] || (rTType == RTT_INT && staticType[RTT_FLOAT]) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func (staticType StaticType) ToString() string {
+	if staticType == nil {
+		return "ANY"
+	}
+
+	names := make([]string, 0, len(staticType))
+	for _, rTType := range staticTypeOrder {
+		if staticType[rTType] {
+			names = append(names, string(rTType))
+		}
+	}
+
+	return strings.Join(names, " | ")
+}
+
+func sampleValue(rTType RTType, pos SEPos) RTValue {
+	switch rTType {
+	case RTT_INT:
+		return NewRTInt(pos, 1, nil)
+	case RTT_FLOAT:
+		return NewRTFloat(pos, 1, nil)
+	case RTT_BOOL:
+		return NewRTBool(pos, true, nil)
+	case RTT_STRING:
+		return NewRTString(pos, "a", nil)
+	case RTT_NULL:
+		return NewRTNull()
+	case RTT_LIST:
+		return NewRTList(pos, make([]RTValue, 0), nil)
+	case RTT_TUPLE:
+		return NewRTTuple(pos, make([]RTValue, 0), nil)
+	case RTT_MAP:
+		return NewRTMap(pos, nil)
+	case RTT_RANGE:
+		return NewRTRange(pos, 0, 1, 1, nil)
+	case RTT_FUNCTION:
+		return NewRTFunction("", make([]Parameter, 0), nil, pos, nil)
+	case RTT_BUILTIN_FUNCTION:
+		return NewRTBuiltinFunction("", make([]string, 0), nil, pos, nil)
+	case RTT_CLASS:
+		return NewRTClass("", nil, make(map[string]*RTFunction, 0), pos, nil)
+	case RTT_INSTANCE:
+		return NewRTInstance(NewRTClass("", nil, make(map[string]*RTFunction, 0), pos, nil), pos, nil)
+	case RTT_MODULE:
+		return NewRTModule("", "", nil, pos, nil)
+	case RTT_GENERATOR:
+		return NewRTGenerator(NewRTFunction("", make([]Parameter, 0), nil, pos, nil), NewEnvironment(nil, "", 1, "", false), pos, nil)
+	case RTT_TASK:
+		return NewRTTask("", pos, nil)
+	case RTT_CHANNEL:
+		return NewRTChannel(0, pos, nil)
+	}
+
+	return nil
+}
+
+type checkFunction struct {
+	name           string
+	parameters     []Parameter
+	parameterTypes []StaticType
+	returnType     StaticType
+	generator      bool
+}
+
+type checkClass struct {
+	name        string
+	known       bool
+	initializer *checkFunction
+	methods     map[string]*checkFunction
+}
+
+type checkVariable struct {
+	staticType StaticType
+	annotated  bool
+	function   *checkFunction
+	class      *checkClass
+	branch     int
+}
+
+type checkScope struct {
+	parent    *checkScope
+	variables map[string]*checkVariable
+}
+
+func (scope *checkScope) lookup(name string) *checkVariable {
+	for scope != nil {
+		if variable, ok := scope.variables[name]; ok {
+			return variable
+		}
+
+		scope = scope.parent
+	}
+
+	return nil
+}
+
+type Checker struct {
+	file        *File
+	interpreter *Interpreter
+	scope       *checkScope
+	functions   []*checkFunction
+	silent      int
+	branch      int
+	branches    int
+	errors      []error
+}
+
+func NewChecker(file *File) *Checker {
+	return &Checker{
+		file:        file,
+		interpreter: NewInterpreter(nil, file, nil),
+		scope:       &checkScope{variables: make(map[string]*checkVariable, 0)},
+		functions:   make([]*checkFunction, 0),
+		errors:      make([]error, 0),
+	}
+}
+
+func (checker *Checker) Check(statements []Stmt) []error {
+	checker.statements(statements)
+
+	return checker.errors
+}
+
+func (checker *Checker) report(errType SnowErrType, msg string, tip string, pos SEPos) {
+	if checker.silent == 0 {
+		checker.errors = append(checker.errors, NewSnowError(errType, msg, tip, pos))
+	}
+}
+
+func (checker *Checker) push() {
+	checker.scope = &checkScope{parent: checker.scope, variables: make(map[string]*checkVariable, 0)}
+}
+
+func (checker *Checker) pop() {
+	checker.scope = checker.scope.parent
+}
+
+func (checker *Checker) declare(name string, variable *checkVariable) {
+	variable.branch = checker.branch
+	checker.scope.variables[name] = variable
+}
+
+func (checker *Checker) resolve(annotation *TypeAnnotation) StaticType {
+	if annotation == nil {
+		return nil
+	}
+
+	staticType := make(StaticType, 0)
+	for _, name := range annotation.Names {
+		if name.Value == "any" {
+			return nil
+		}
+
+		if rTTypes, ok := annotationTypes[name.Value]; ok {
+			for _, rTType := range rTTypes {
+				staticType[rTType] = true
+			}
+
+			continue
+		}
+
+		variable := checker.scope.lookup(name.Value)
+		if variable == nil {
+			checker.report(
+				TYPE_ERROR,
+				fmt.Sprintf("unknown type '%s'", name.Value),
+				annotationTypesTip(),
+				name.Pos,
+			)
+
+			return nil
+		} else if variable.class == nil {
+			return nil
+		}
+
+		staticType[RTT_INSTANCE] = true
+	}
+
+	if annotation.Optional {
+		staticType[RTT_NULL] = true
+	}
+
+	return staticType
+}
+
+func (checker *Checker) newFunction(name string, parameters []Parameter, returnType *TypeAnnotation, generator bool) *checkFunction {
+	parameterTypes := make([]StaticType, 0, len(parameters))
+	for _, parameter := range parameters {
+		parameterTypes = append(parameterTypes, checker.resolve(parameter.Type))
+	}
+
+	return &checkFunction{
+		name:           name,
+		parameters:     parameters,
+		parameterTypes: parameterTypes,
+		returnType:     checker.resolve(returnType),
+		generator:      generator,
+	}
+}
+
+func (checker *Checker) newClass(stmt *ClassDeclStmt) *checkClass {
+	class := &checkClass{
+		name:    stmt.Name,
+		known:   true,
+		methods: make(map[string]*checkFunction, 0),
+	}
+
+	for _, method := range stmt.Methods {
+		class.methods[method.Name] = checker.newFunction(method.Name, method.Parameters, method.ReturnType, method.Generator)
+	}
+
+	if initializer, ok := class.methods["init"]; ok {
+		initializer.name = stmt.Name
+		class.initializer = initializer
+	} else if stmt.SuperClass != nil {
+		superClass := checker.scope.lookup(stmt.SuperClass.Value)
+		if superClass != nil && superClass.class != nil {
+			class.known = superClass.class.known
+			class.initializer = superClass.class.initializer
+		} else {
+			class.known = false
+		}
+	}
+
+	return class
+}
+
+func (checker *Checker) hoist(statements []Stmt) {
+	for _, stmt := range statements {
+		if classDecl, ok := stmt.(*ClassDeclStmt); ok {
+			checker.declare(classDecl.Name, &checkVariable{
+				staticType: NewStaticType(RTT_CLASS),
+				annotated:  true,
+				class:      &checkClass{name: classDecl.Name},
+			})
+		}
+	}
+
+	for _, stmt := range statements {
+		switch stmt := stmt.(type) {
+		case *FunctionDeclStmt:
+			checker.declare(stmt.Name, &checkVariable{
+				staticType: NewStaticType(RTT_FUNCTION),
+				annotated:  true,
+				function:   checker.newFunction(stmt.Name, stmt.Parameters, stmt.ReturnType, stmt.Generator),
+			})
+		case *ClassDeclStmt:
+			checker.scope.variables[stmt.Name].class = checker.newClass(stmt)
+		}
+	}
+}
+
+func (checker *Checker) statements(statements []Stmt) {
+	checker.hoist(statements)
+
+	for _, stmt := range statements {
+		checker.statement(stmt)
+	}
+}
+
+func (checker *Checker) loop(stmt Stmt) {
+	checker.conditionally(func() {
+		checker.silent++
+		checker.statement(stmt)
+		checker.silent--
+
+		checker.statement(stmt)
+	})
+}
+
+func (checker *Checker) conditionally(check func()) {
+	branch := checker.branch
+	checker.branches++
+	checker.branch = checker.branches
+
+	check()
+
+	checker.branch = branch
+}
+
+func (checker *Checker) statement(stmt Stmt) {
+	switch stmt := stmt.(type) {
+	case *ExpressionStmt:
+		checker.expression(stmt.Expression)
+	case *VarDeclStmt:
+		checker.varDecl(stmt)
+	case *BlockStmt:
+		checker.push()
+		checker.statements(stmt.Statements)
+		checker.pop()
+	case *WhileStmt:
+		checker.expression(stmt.Expression)
+		checker.loop(stmt.Statement)
+	case *ForStmt:
+		checker.expression(stmt.Iterable)
+
+		checker.push()
+		checker.declare(stmt.Identifier.Value, &checkVariable{})
+		checker.loop(stmt.Statement)
+		checker.pop()
+	case *FunctionDeclStmt:
+		variable := checker.scope.lookup(stmt.Name)
+		if variable == nil || variable.function == nil {
+			variable = &checkVariable{function: checker.newFunction(stmt.Name, stmt.Parameters, stmt.ReturnType, stmt.Generator)}
+		}
+
+		checker.function(variable.function, stmt.Block)
+	case *ReturnStmt:
+		checker.returnStmt(stmt)
+	case *IfStmtContainer:
+		for _, ifStmt := range stmt.IfStmts {
+			checker.statement(&ifStmt)
+		}
+	case *IfStmt:
+		if stmt.Expression != nil {
+			checker.expression(stmt.Expression)
+		}
+
+		checker.conditionally(func() { checker.statement(stmt.Statement) })
+	case *ClassDeclStmt:
+		if stmt.SuperClass != nil {
+			checker.expression(stmt.SuperClass)
+		}
+
+		methods := make(map[string]*checkFunction, 0)
+		if variable := checker.scope.lookup(stmt.Name); variable != nil && variable.class != nil {
+			methods = variable.class.methods
+		}
+
+		for _, method := range stmt.Methods {
+			function, ok := methods[method.Name]
+			if !ok {
+				function = checker.newFunction(method.Name, method.Parameters, method.ReturnType, method.Generator)
+			}
+
+			checker.function(function, method.Block)
+		}
+	case *MatchStmt:
+		checker.expression(stmt.Value)
+
+		for _, matchCase := range stmt.Cases {
+			checker.push()
+			checker.conditionally(func() {
+				for _, pattern := range matchCase.Patterns {
+					checker.pattern(pattern)
+				}
+
+				if matchCase.Guard != nil {
+					checker.expression(matchCase.Guard)
+				}
+
+				checker.statement(matchCase.Statement)
+			})
+			checker.pop()
+		}
+	case *SelectStmt:
+		for _, selectCase := range stmt.Cases {
+			checker.expression(selectCase.Channel)
+
+			if selectCase.Send {
+				checker.expression(selectCase.Value)
+			}
+
+			checker.push()
+
+			if selectCase.Name != nil {
+				checker.declare(selectCase.Name.Value, &checkVariable{})
+			}
+
+			checker.conditionally(func() { checker.statement(selectCase.Statement) })
+			checker.pop()
+		}
+
+		if stmt.Else != nil {
+			checker.conditionally(func() { checker.statement(stmt.Else) })
+		}
+	case *ThrowStmt:
+		checker.expression(stmt.Value)
+	case *YieldStmt:
+		if stmt.Value != nil {
+			checker.expression(stmt.Value)
+		}
+	case *TryStmt:
+		checker.conditionally(func() { checker.statement(stmt.Statement) })
+
+		for _, catch := range stmt.Catches {
+			for _, catchType := range catch.Types {
+				checker.expression(catchType)
+			}
+
+			checker.push()
+
+			if catch.Name != nil {
+				checker.declare(catch.Name.Value, &checkVariable{staticType: NewStaticType(RTT_ERROR)})
+			}
+
+			checker.conditionally(func() { checker.statement(catch.Statement) })
+			checker.pop()
+		}
+
+		if stmt.Finally != nil {
+			checker.conditionally(func() { checker.statement(stmt.Finally) })
+		}
+	case *ImportStmt:
+		if len(stmt.Names) == 0 {
+			checker.declare(stmt.Alias.Value, &checkVariable{staticType: NewStaticType(RTT_MODULE)})
+		}
+
+		for _, name := range stmt.Names {
+			checker.declare(name.Value, &checkVariable{})
+		}
+	}
+}
+
+func (checker *Checker) varDecl(stmt *VarDeclStmt) {
+	valueType := checker.expression(stmt.Expression)
+
+	if stmt.Pattern != nil {
+		checker.pattern(stmt.Pattern)
+
+		return
+	}
+
+	if stmt.Type != nil {
+		staticType := checker.resolve(stmt.Type)
+		if !staticType.Accepts(valueType) {
+			checker.report(
+				TYPE_ERROR,
+				fmt.Sprintf("unable to assign a value of type '%s' to the variable '%s' of type '%s'", valueType.ToString(), stmt.Identifier.Value, staticType.ToString()),
+				"",
+				stmt.Expression.GetPosition(),
+			)
+		}
+
+		checker.declare(stmt.Identifier.Value, &checkVariable{staticType: staticType, annotated: true})
+
+		return
+	}
+
+	if stmt.VarType.TType == VAR && valueType != nil && len(valueType) == 1 && valueType[RTT_NULL] {
+		valueType = nil
+	}
+
+	checker.declare(stmt.Identifier.Value, &checkVariable{staticType: valueType})
+}
+
+func (checker *Checker) function(function *checkFunction, block *BlockStmt) {
+	checker.push()
+	checker.conditionally(func() { checker.functionBody(function, block) })
+	checker.pop()
+}
+
+func (checker *Checker) functionBody(function *checkFunction, block *BlockStmt) {
+
+	for index, parameter := range function.parameters {
+		staticType := function.parameterTypes[index]
+
+		if parameter.Default != nil {
+			defaultType := checker.expression(parameter.Default)
+			if !staticType.Accepts(defaultType) {
+				checker.report(
+					TYPE_ERROR,
+					fmt.Sprintf("unable to use a value of type '%s' as the default of the parameter '%s' of type '%s'", defaultType.ToString(), parameter.Name.Value, staticType.ToString()),
+					"",
+					parameter.Default.GetPosition(),
+				)
+			}
+		}
+
+		if parameter.Variadic {
+			staticType = NewStaticType(RTT_LIST)
+		}
+
+		checker.declare(parameter.Name.Value, &checkVariable{staticType: staticType, annotated: parameter.Type != nil || parameter.Variadic})
+	}
+
+	checker.functions = append(checker.functions, function)
+	checker.statements(block.Statements)
+	checker.functions = checker.functions[:len(checker.functions)-1]
+}
+
+func (checker *Checker) returnStmt(stmt *ReturnStmt) {
+	valueType := NewStaticType(RTT_NULL)
+	pos := stmt.Pos
+	if stmt.Value != nil {
+		valueType = checker.expression(stmt.Value)
+		pos = stmt.Value.GetPosition()
+	}
+
+	if len(checker.functions) == 0 {
+		return
+	}
+
+	function := checker.functions[len(checker.functions)-1]
+	if function.generator || function.returnType.Accepts(valueType) {
+		return
+	}
+
+	name := "an anonymous function"
+	if function.name != "" {
+		name = fmt.Sprintf("the function '%s'", function.name)
+	}
+
+	checker.report(
+		TYPE_ERROR,
+		fmt.Sprintf("unable to return a value of type '%s' from %s with the return type '%s'", valueType.ToString(), name, function.returnType.ToString()),
+		"",
+		pos,
+	)
+}
+
+func (checker *Checker) pattern(pattern Pattern) {
+	switch pattern := pattern.(type) {
+	case *LiteralPattern:
+		checker.expression(pattern.Value)
+	case *BindingPattern:
+		checker.declare(pattern.Name, &checkVariable{})
+	case *ListPattern:
+		for _, element := range pattern.Before {
+			checker.pattern(element)
+		}
+
+		if pattern.Rest != nil && pattern.Rest.Value != "_" {
+			checker.declare(pattern.Rest.Value, &checkVariable{staticType: NewStaticType(RTT_LIST)})
+		}
+
+		for _, element := range pattern.After {
+			checker.pattern(element)
+		}
+	case *TuplePattern:
+		for _, element := range pattern.Elements {
+			checker.pattern(element)
+		}
+	case *MapPattern:
+		for index, key := range pattern.Keys {
+			checker.expression(key)
+			checker.pattern(pattern.Values[index])
+		}
+	case *ClassPattern:
+		checker.expression(pattern.Class)
+
+		for _, field := range pattern.Patterns {
+			checker.pattern(field)
+		}
+	case *DefaultPattern:
+		checker.expression(pattern.Default)
+		checker.pattern(pattern.Pattern)
+	}
+}
+
+func (checker *Checker) assign(name string, valueType StaticType, pos SEPos) {
+	variable := checker.scope.lookup(name)
+	if variable == nil {
+		return
+	}
+
+	if variable.annotated {
+		if !variable.staticType.Accepts(valueType) {
+			checker.report(
+				TYPE_ERROR,
+				fmt.Sprintf("unable to assign a value of type '%s' to the variable '%s' of type '%s'", valueType.ToString(), name, variable.staticType.ToString()),
+				"",
+				pos,
+			)
+		}
+
+		return
+	}
+
+	if variable.branch == checker.branch {
+		variable.staticType = valueType
+	} else {
+		variable.staticType = variable.staticType.Union(valueType)
+	}
+
+	variable.function = nil
+	variable.class = nil
+}
+
+func (checker *Checker) operation(op Token, left StaticType, right StaticType, pos SEPos) StaticType {
+	if left == nil || right == nil {
+		return nil
+	}
+
+	result := make(StaticType, 0)
+	for leftType := range left {
+		for rightType := range right {
+			leftValue, rightValue := sampleValue(leftType, pos), sampleValue(rightType, pos)
+			if leftValue == nil || rightValue == nil {
+				return nil
+			}
+
+			value, err := checker.interpreter.binaryOperation(leftValue, rightValue, op, pos)
+			if err != nil {
+				if rTError, ok := err.(*RTError); ok && rTError.ErrType == VALUE_ERROR {
+					continue
+				}
+
+				return nil
+			}
+
+			result[value.GetType()] = true
+
+			if op.TType == DOUBLE_STAR && leftType == RTT_INT && rightType == RTT_INT {
+				result[RTT_FLOAT] = true
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		operation, withBy := valueErrorOperation(op.TType)
+
+		checker.report(
+			TYPE_ERROR,
+			fmt.Sprintf("unable to %s '%s' %s '%s'", operation, left.ToString(), withBy, right.ToString()),
+			"",
+			pos,
+		)
+
+		return nil
+	}
+
+	return result
+}
+
+func (checker *Checker) unaryOperation(op Token, right StaticType, pos SEPos) StaticType {
+	if op.TType == NOT {
+		return NewStaticType(RTT_BOOL)
+	} else if right == nil {
+		return nil
+	}
+
+	result := make(StaticType, 0)
+	for rightType := range right {
+		rightValue := sampleValue(rightType, pos)
+		if rightValue == nil {
+			return nil
+		}
+
+		var value RTValue
+		var err error
+		if op.TType == DASH {
+			value, err = rightValue.Multiply(NewRTInt(pos, -1, nil), pos)
+		} else {
+			value, err = rightValue.BitwiseNot(pos)
+		}
+
+		if err != nil {
+			if rTError, ok := err.(*RTError); ok && rTError.ErrType == VALUE_ERROR {
+				continue
+			}
+
+			return nil
+		}
+
+		result[value.GetType()] = true
+	}
+
+	if len(result) == 0 {
+		operation := "negate"
+		if op.TType == TILDE {
+			operation, _ = valueErrorOperation(TILDE)
+		}
+
+		checker.report(
+			TYPE_ERROR,
+			fmt.Sprintf("unable to %s '%s'", operation, right.ToString()),
+			"",
+			pos,
+		)
+
+		return nil
+	}
+
+	return result
+}
+
+func (checker *Checker) expression(expr Expr) StaticType {
+	switch expr := expr.(type) {
+	case *BinaryExpr:
+		left := checker.expression(expr.Left)
+		right := checker.expression(expr.Right)
+
+		return checker.operation(expr.Tok, left, right, expr.Pos)
+	case *LogicalExpr:
+		left := checker.expression(expr.Left)
+
+		var right StaticType
+		checker.conditionally(func() { right = checker.expression(expr.Right) })
+
+		return left.Union(right)
+	case *UnaryExpr:
+		return checker.unaryOperation(expr.Tok, checker.expression(expr.Right), expr.Pos)
+	case *GroupingExpr:
+		return checker.expression(expr.Expression)
+	case *IntLiteralExpr:
+		return NewStaticType(RTT_INT)
+	case *FloatLiteralExpr:
+		return NewStaticType(RTT_FLOAT)
+	case *BoolLiteralExpr:
+		return NewStaticType(RTT_BOOL)
+	case *StringLiteralExpr:
+		return NewStaticType(RTT_STRING)
+	case *StringifyExpr:
+		checker.expression(expr.Value)
+
+		return NewStaticType(RTT_STRING)
+	case *NullLiteralExpr:
+		return NewStaticType(RTT_NULL)
+	case *VarAccessExpr:
+		if variable := checker.scope.lookup(expr.Value); variable != nil {
+			return variable.staticType
+		}
+
+		return nil
+	case *VarAssignmentExpr:
+		return checker.varAssignment(expr)
+	case *DotExpr:
+		checker.expression(expr.Left)
+
+		return nil
+	case *CallExpr:
+		return checker.call(expr)
+	case *SpawnExpr:
+		checker.call(expr.Call)
+
+		return NewStaticType(RTT_TASK)
+	case *AwaitExpr:
+		value := checker.expression(expr.Value)
+
+		if value != nil && !value[RTT_TASK] {
+			checker.report(
+				TYPE_ERROR,
+				fmt.Sprintf("unable to await object of type '%s'", value.ToString()),
+				"",
+				expr.Value.GetPosition(),
+			)
+		}
+
+		return nil
+	case *ListLiteralExpr:
+		for _, element := range expr.Elements {
+			checker.expression(element)
+		}
+
+		return NewStaticType(RTT_LIST)
+	case *TupleLiteralExpr:
+		for _, element := range expr.Elements {
+			checker.expression(element)
+		}
+
+		return NewStaticType(RTT_TUPLE)
+	case *IndexExpr:
+		object := checker.expression(expr.Object)
+		checker.expression(expr.Index)
+
+		if object != nil && len(object) == 1 && object[RTT_STRING] {
+			return object
+		}
+
+		return nil
+	case *SliceExpr:
+		object := checker.expression(expr.Object)
+
+		if expr.Start != nil {
+			checker.expression(expr.Start)
+		}
+
+		if expr.End != nil {
+			checker.expression(expr.End)
+		}
+
+		if object != nil && len(object) == 1 && (object[RTT_STRING] || object[RTT_LIST] || object[RTT_TUPLE]) {
+			return object
+		}
+
+		return nil
+	case *IndexAssignmentExpr:
+		checker.expression(expr.Object)
+		checker.expression(expr.Index)
+
+		if expr.Op != nil {
+			checker.expression(expr.Value)
+
+			return nil
+		}
+
+		return checker.expression(expr.Value)
+	case *MapLiteralExpr:
+		for index, key := range expr.Keys {
+			checker.expression(key)
+			checker.expression(expr.Values[index])
+		}
+
+		return NewStaticType(RTT_MAP)
+	case *FunctionExpr:
+		checker.function(checker.newFunction("", expr.Parameters, expr.ReturnType, expr.Generator), expr.Block)
+
+		return NewStaticType(RTT_FUNCTION)
+	case *ConditionalExpr:
+		checker.expression(expr.Condition)
+		var then, elseType StaticType
+		checker.conditionally(func() {
+			then = checker.expression(expr.Then)
+			elseType = checker.expression(expr.Else)
+		})
+
+		return then.Union(elseType)
+	}
+
+	return nil
+}
+
+func (checker *Checker) varAssignment(expr *VarAssignmentExpr) StaticType {
+	valueType := checker.expression(expr.Value)
+
+	if expr.Pattern != nil {
+		for _, name := range patternNames(expr.Pattern) {
+			checker.assign(name.Value, nil, expr.Pos)
+		}
+
+		return valueType
+	} else if expr.Object != nil {
+		checker.expression(expr.Object)
+
+		if expr.Op != nil {
+			return nil
+		}
+
+		return valueType
+	}
+
+	variable := checker.scope.lookup(expr.Name)
+	if variable == nil {
+		return nil
+	}
+
+	current := variable.staticType
+	if expr.Op != nil {
+		valueType = checker.operation(*expr.Op, current, valueType, expr.Pos)
+	}
+
+	checker.assign(expr.Name, valueType, expr.Pos)
+
+	if expr.Postfix {
+		return current
+	}
+
+	return valueType
+}
+
+func (checker *Checker) call(expr *CallExpr) StaticType {
+	callee := checker.expression(expr.Function)
+
+	argumentTypes := make([]StaticType, 0, len(expr.Arguments))
+	for _, argument := range expr.Arguments {
+		argumentTypes = append(argumentTypes, checker.expression(argument.Value))
+	}
+
+	if callee != nil && !callee[RTT_FUNCTION] && !callee[RTT_BUILTIN_FUNCTION] && !callee[RTT_CLASS] {
+		checker.report(
+			TYPE_ERROR,
+			fmt.Sprintf("unable to call object of type '%s'", callee.ToString()),
+			"",
+			expr.Function.GetPosition(),
+		)
+
+		return nil
+	}
+
+	access, ok := expr.Function.(*VarAccessExpr)
+	if !ok {
+		return nil
+	}
+
+	variable := checker.scope.lookup(access.Value)
+	if variable == nil {
+		return nil
+	}
+
+	if variable.function != nil {
+		checker.arguments(variable.function, expr, argumentTypes)
+
+		if variable.function.generator {
+			return NewStaticType(RTT_GENERATOR)
+		}
+
+		return variable.function.returnType
+	} else if variable.class != nil {
+		if variable.class.initializer != nil {
+			checker.arguments(variable.class.initializer, expr, argumentTypes)
+		} else if variable.class.known && len(expr.Arguments) != 0 {
+			checker.report(
+				ARGUMENT_ERROR,
+				fmt.Sprintf("too many arguments, the class '%s' expected 0 arguments but got %d arguments", variable.class.name, len(expr.Arguments)),
+				"",
+				expr.Arguments[0].Value.GetPosition(),
+			)
+		}
+
+		return NewStaticType(RTT_INSTANCE)
+	}
+
+	return nil
+}
+
+func (checker *Checker) arguments(function *checkFunction, expr *CallExpr, argumentTypes []StaticType) {
+	for _, argument := range expr.Arguments {
+		if argument.Spread {
+			return
+		}
+	}
+
+	fixed := len(function.parameters)
+	if fixed > 0 && function.parameters[fixed-1].Variadic {
+		fixed--
+	}
+
+	supplied := make([]bool, fixed)
+	position := 0
+
+	for index, argument := range expr.Arguments {
+		if argument.Name != nil {
+			parameterIndex := -1
+			for i := 0; i < fixed; i++ {
+				if function.parameters[i].Name.Value == argument.Name.Value {
+					parameterIndex = i
+				}
+			}
+
+			if parameterIndex == -1 {
+				checker.report(
+					ARGUMENT_ERROR,
+					fmt.Sprintf("'%s' has no parameter called '%s'", function.name, argument.Name.Value),
+					"",
+					argument.Name.Pos,
+				)
+
+				continue
+			} else if supplied[parameterIndex] {
+				checker.report(
+					ARGUMENT_ERROR,
+					fmt.Sprintf("'%s' got more than one value for the parameter '%s'", function.name, argument.Name.Value),
+					"",
+					argument.Name.Pos,
+				)
+
+				continue
+			}
+
+			supplied[parameterIndex] = true
+			checker.argument(function, parameterIndex, argumentTypes[index], argument.Value.GetPosition())
+
+			continue
+		}
+
+		if position < fixed {
+			supplied[position] = true
+			checker.argument(function, position, argumentTypes[index], argument.Value.GetPosition())
+
+			position++
+		} else if fixed < len(function.parameters) {
+			checker.argument(function, fixed, argumentTypes[index], argument.Value.GetPosition())
+		} else {
+			checker.report(
+				ARGUMENT_ERROR,
+				fmt.Sprintf("too many arguments, '%s' expected %d arguments but got %d arguments", function.name, fixed, len(expr.Arguments)),
+				"",
+				argument.Value.GetPosition(),
+			)
+
+			return
+		}
+	}
+
+	for index := 0; index < fixed; index++ {
+		parameter := function.parameters[index]
+		if !supplied[index] && parameter.Default == nil {
+			checker.report(
+				ARGUMENT_ERROR,
+				fmt.Sprintf("too few arguments, the parameter '%s' of '%s' is missing a value", parameter.Name.Value, function.name),
+				"",
+				expr.Pos,
+			)
+		}
+	}
+}
+
+func (checker *Checker) argument(function *checkFunction, index int, argumentType StaticType, pos SEPos) {
+	parameterType := function.parameterTypes[index]
+	if parameterType.Accepts(argumentType) {
+		return
+	}
+
+	checker.report(
+		TYPE_ERROR,
+		fmt.Sprintf("unable to pass a value of type '%s' to the parameter '%s' of type '%s'", argumentType.ToString(), function.parameters[index].Name.Value, parameterType.ToString()),
+		"",
+		pos,
+	)
+}
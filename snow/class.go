@@ -0,0 +1,306 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type RTClass struct {
+	Name        string
+	SuperClass  *RTClass
+	Methods     map[string]*RTFunction
+	Pos         SEPos
+	Environment *Environment
+}
+
+func NewRTClass(name string, superClass *RTClass, methods map[string]*RTFunction, pos SEPos, env *Environment) *RTClass {
+	return &RTClass{
+		Name:        name,
+		SuperClass:  superClass,
+		Methods:     methods,
+		Pos:         pos,
+		Environment: env,
+	}
+}
+
+func (rTClass *RTClass) FindMethod(name string) *RTFunction {
+	if method, ok := rTClass.Methods[name]; ok {
+		return method
+	}
+
+	if rTClass.SuperClass != nil {
+		return rTClass.SuperClass.FindMethod(name)
+	}
+
+	return nil
+}
+
+func (rTClass *RTClass) IsSubclassOf(other *RTClass) bool {
+	for class := rTClass; class != nil; class = class.SuperClass {
+		if class == other {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rTClass *RTClass) ToString() string {
+	return fmt.Sprintf("(CLASS: %s)", rTClass.Name)
+}
+
+func (rTClass *RTClass) ValueToString() string {
+	return fmt.Sprintf("CLASS %s", rTClass.Name)
+}
+
+func (rTClass *RTClass) GetType() RTType {
+	return RTT_CLASS
+}
+
+func (rTClass *RTClass) GetValue() interface{} {
+	return fmt.Sprintf("CLASS %s", rTClass.Name)
+}
+
+func (rTClass *RTClass) GetEnvironment() *Environment {
+	return rTClass.Environment
+}
+
+func (rTClass *RTClass) Dot(other Token, position SEPos) (RTValue, error) {
+	method := rTClass.FindMethod(other.Value)
+	if method == nil {
+		return nil, NewInvalidAttributeRTError(rTClass, other, position, rTClass.Environment)
+	}
+
+	return method, nil
+}
+
+func (rTClass *RTClass) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTClass, other, value, position, rTClass.Environment)
+}
+
+func (rTClass *RTClass) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTClass, position, rTClass.Environment)
+}
+
+func (rTClass *RTClass) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTClass, position, rTClass.Environment)
+}
+
+func (rTClass *RTClass) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTClass, position, rTClass.Environment)
+}
+
+func (rTClass *RTClass) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTClass, position, rTClass.Environment)
+}
+
+func (rTClass *RTClass) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTClass, position, rTClass.Environment)
+}
+
+func (rTClass *RTClass) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTClass), rTClass.Environment), nil
+}
+
+func (rTClass *RTClass) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTClass), rTClass.Environment), nil
+}
+
+func (rTClass *RTClass) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTClass,
+		other,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTClass.Environment), nil
+}
+
+func (rTClass *RTClass) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTClass,
+		nil,
+		position,
+		rTClass.Environment,
+	)
+}
+
+func (rTClass *RTClass) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTClass.Environment), nil
+}
+
+func (rTClass *RTClass) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	instance := NewRTInstance(rTClass, position, interpreter.currentEnv())
+
+	initializer := rTClass.FindMethod("init")
+	if initializer == nil {
+		if len(arguments) != 0 {
+			return nil, NewTooManyArgumentsRTError(rTClass, 0, len(arguments), arguments[0], position, interpreter.currentEnv())
+		} else if len(namedArguments) != 0 {
+			return nil, NewUnexpectedNamedArgumentRTError(rTClass, namedArguments[0].Name, namedArguments[0].Pos, interpreter.currentEnv())
+		}
+
+		return instance, nil
+	}
+
+	_, err := initializer.Bind(instance).Call(arguments, namedArguments, position, interpreter)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
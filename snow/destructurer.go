@@ -0,0 +1,223 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type Destructurer struct {
+	interpreter *Interpreter
+	constant    bool
+	assign      bool
+}
+
+func NewDestructurer(interpreter *Interpreter, constant bool, assign bool) *Destructurer {
+	return &Destructurer{
+		interpreter: interpreter,
+		constant:    constant,
+		assign:      assign,
+	}
+}
+
+func (destructurer *Destructurer) Destructure(pattern Pattern, value RTValue, env *Environment) error {
+	_, err := pattern.Accept(destructurer, value, env)
+
+	return err
+}
+
+func (destructurer *Destructurer) bind(name string, value RTValue, pos SEPos, env *Environment) error {
+	if destructurer.assign {
+		_, err := env.Set(name, value, env, pos)
+
+		return err
+	}
+
+	return env.Declare(destructurer.constant, name, value, pos)
+}
+
+func (destructurer *Destructurer) missing(pattern Pattern, description string, env *Environment) error {
+	if defaultPattern, ok := pattern.(*DefaultPattern); ok {
+		value, err := destructurer.interpreter.evaluate(defaultPattern.Default, env)
+		if err != nil {
+			return err
+		}
+
+		return destructurer.Destructure(defaultPattern.Pattern, value, env)
+	}
+
+	return NewDestructuringRTError(fmt.Sprintf("there is no value for the pattern %s", description), pattern.GetPosition(), env)
+}
+
+func (destructurer *Destructurer) VisitLiteralPattern(pattern LiteralPattern, value RTValue, env *Environment) (bool, error) {
+	matched, err := destructurer.interpreter.VisitLiteralPattern(pattern, value, env)
+	if err != nil {
+		return false, err
+	}
+
+	if !matched {
+		return false, NewDestructuringRTError(fmt.Sprintf("expected the value '%s' but got '%s'", pattern.Value.ToString(), value.ValueToString()), pattern.Pos, env)
+	}
+
+	return true, nil
+}
+
+func (destructurer *Destructurer) VisitWildcardPattern(pattern WildcardPattern, value RTValue, env *Environment) (bool, error) {
+	return true, nil
+}
+
+func (destructurer *Destructurer) VisitBindingPattern(pattern BindingPattern, value RTValue, env *Environment) (bool, error) {
+	return true, destructurer.bind(pattern.Name, value, pattern.Pos, env)
+}
+
+func (destructurer *Destructurer) VisitListPattern(pattern ListPattern, value RTValue, env *Environment) (bool, error) {
+	list, ok := value.(*RTList)
+	if !ok {
+		return false, NewDestructuringRTError(fmt.Sprintf("expected a value of type '%s' but got '%s' with value of '%s'", RTT_LIST, value.GetType(), value.ValueToString()), pattern.Pos, env)
+	}
+
+	values := list.values()
+
+	if pattern.Rest == nil {
+		if len(values) > len(pattern.Before) {
+			return false, NewDestructuringRTError(fmt.Sprintf("too many values, expected at most %d values but got %d", len(pattern.Before), len(values)), pattern.Pos, env)
+		}
+
+		for index, elementPattern := range pattern.Before {
+			var err error
+			if index < len(values) {
+				err = destructurer.Destructure(elementPattern, values[index], env)
+			} else {
+				err = destructurer.missing(elementPattern, fmt.Sprintf("at index %d", index), env)
+			}
+
+			if err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}
+
+	afterStart := len(values) - len(pattern.After)
+	if afterStart < len(pattern.Before) {
+		return false, NewDestructuringRTError(fmt.Sprintf("not enough values, expected at least %d values but got %d", len(pattern.Before)+len(pattern.After), len(values)), pattern.Pos, env)
+	}
+
+	for index, elementPattern := range pattern.Before {
+		err := destructurer.Destructure(elementPattern, values[index], env)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for index, elementPattern := range pattern.After {
+		err := destructurer.Destructure(elementPattern, values[afterStart+index], env)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if pattern.Rest.Value != "_" {
+		rest := make([]RTValue, afterStart-len(pattern.Before))
+		copy(rest, values[len(pattern.Before):afterStart])
+
+		err := destructurer.bind(pattern.Rest.Value, NewRTList(pattern.Rest.Pos, rest, env), pattern.Rest.Pos, env)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (destructurer *Destructurer) VisitTuplePattern(pattern TuplePattern, value RTValue, env *Environment) (bool, error) {
+	tuple, ok := value.(*RTTuple)
+	if !ok {
+		return false, NewDestructuringRTError(fmt.Sprintf("expected a value of type '%s' but got '%s' with value of '%s'", RTT_TUPLE, value.GetType(), value.ValueToString()), pattern.Pos, env)
+	}
+
+	if len(tuple.Values) != len(pattern.Elements) {
+		return false, NewDestructuringRTError(fmt.Sprintf("expected a tuple of %d values but got %d", len(pattern.Elements), len(tuple.Values)), pattern.Pos, env)
+	}
+
+	for index, elementPattern := range pattern.Elements {
+		err := destructurer.Destructure(elementPattern, tuple.Values[index], env)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (destructurer *Destructurer) VisitMapPattern(pattern MapPattern, value RTValue, env *Environment) (bool, error) {
+	rTMap, ok := value.(*RTMap)
+	if !ok {
+		return false, NewDestructuringRTError(fmt.Sprintf("expected a value of type '%s' but got '%s' with value of '%s'", RTT_MAP, value.GetType(), value.ValueToString()), pattern.Pos, env)
+	}
+
+	for index, keyExpr := range pattern.Keys {
+		key, err := destructurer.interpreter.evaluate(keyExpr, env)
+		if err != nil {
+			return false, err
+		}
+
+		entry, found, err := rTMap.Get(key, keyExpr.GetPosition())
+		if err != nil {
+			return false, err
+		}
+
+		if found {
+			err = destructurer.Destructure(pattern.Values[index], entry, env)
+		} else {
+			err = destructurer.missing(pattern.Values[index], fmt.Sprintf("with the key '%s'", key.ValueToString()), env)
+		}
+
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (destructurer *Destructurer) VisitClassPattern(pattern ClassPattern, value RTValue, env *Environment) (bool, error) {
+	classValue, err := destructurer.interpreter.evaluate(pattern.Class, env)
+	if err != nil {
+		return false, err
+	}
+
+	class, ok := classValue.(*RTClass)
+	if !ok {
+		return false, NewRuntimeError(
+			INVALID_PATTERN_ERROR,
+			fmt.Sprintf("'%s' is of type '%s' and can not be used as a class pattern", pattern.Class.Value, classValue.GetType()),
+			"",
+			pattern.Class.Pos,
+			env,
+		)
+	}
+
+	instance, ok := value.(*RTInstance)
+	if !ok || !instance.Class.IsSubclassOf(class) {
+		return false, NewDestructuringRTError(fmt.Sprintf("expected an instance of '%s' but got '%s' with value of '%s'", class.Name, value.GetType(), value.ValueToString()), pattern.Pos, env)
+	}
+
+	for index, field := range pattern.Fields {
+		fieldValue, ok := instance.Field(field.Value)
+		if ok {
+			err = destructurer.Destructure(pattern.Patterns[index], fieldValue, env)
+		} else {
+			err = destructurer.missing(pattern.Patterns[index], fmt.Sprintf("for the field '%s'", field.Value), env)
+		}
+
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (destructurer *Destructurer) VisitDefaultPattern(pattern DefaultPattern, value RTValue, env *Environment) (bool, error) {
+	return true, destructurer.Destructure(pattern.Pattern, value, env)
+}
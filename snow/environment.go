@@ -2,6 +2,7 @@ package snow
 
 import (
 	"fmt"
+	"sync"
 )
 
 type variable struct {
@@ -12,7 +13,9 @@ type variable struct {
 
 type Environment struct {
 	Parent    *Environment
+	Caller    *Environment
 	vars      map[string]variable
+	mutex     sync.RWMutex
 	StartLine int
 	FileName  string
 	Name      string
@@ -31,6 +34,9 @@ func NewEnvironment(parent *Environment, name string, startLine int, fileName st
 }
 
 func (environment *Environment) Declare(constant bool, name string, value RTValue, pos SEPos) error {
+	environment.mutex.Lock()
+	defer environment.mutex.Unlock()
+
 	if v, ok := environment.vars[name]; ok {
 		return NewRuntimeError(
 			VARIABLE_ALREADY_DECLARED_ERROR,
@@ -51,11 +57,19 @@ func (environment *Environment) Declare(constant bool, name string, value RTValu
 }
 
 func (environment *Environment) Get(name string, pos SEPos, env *Environment) (RTValue, error) {
-	if _, ok := environment.vars[name]; !ok {
+	environment.mutex.RLock()
+	v, ok := environment.vars[name]
+	environment.mutex.RUnlock()
+
+	if !ok {
 		if environment.Parent != nil {
 			return environment.Parent.Get(name, pos, env)
 		}
 
+		if builtin, ok := builtins[name]; ok {
+			return builtin, nil
+		}
+
 		return nil, NewRuntimeError(
 			UNDEFINED_VARIABLE_ERROR,
 			fmt.Sprintf("a variable with the name of '%s' could not be found", name),
@@ -65,11 +79,26 @@ func (environment *Environment) Get(name string, pos SEPos, env *Environment) (R
 		)
 	}
 
-	return environment.vars[name].Value, nil
+	return v.Value, nil
+}
+
+func (environment *Environment) Lookup(name string) (RTValue, bool) {
+	environment.mutex.RLock()
+	defer environment.mutex.RUnlock()
+
+	if v, ok := environment.vars[name]; ok {
+		return v.Value, true
+	}
+
+	return nil, false
 }
 
 func (environment *Environment) Set(name string, value RTValue, env *Environment, pos SEPos) (RTValue, error) {
-	if _, ok := environment.vars[name]; !ok {
+	environment.mutex.Lock()
+	defer environment.mutex.Unlock()
+
+	v, ok := environment.vars[name]
+	if !ok {
 		if environment.Parent != nil {
 			return environment.Parent.Set(name, value, env, pos)
 		}
@@ -83,7 +112,6 @@ func (environment *Environment) Set(name string, value RTValue, env *Environment
 		)
 	}
 
-	v := environment.vars[name]
 	if v.Constant {
 		return nil, NewRuntimeError(
 			CONSTANT_VARIABLE_ASSIGNMENT_ERROR,
@@ -104,3 +132,46 @@ func (environment *Environment) Set(name string, value RTValue, env *Environment
 
 	return value, nil
 }
+
+func (environment *Environment) Update(name string, update func(current RTValue) (RTValue, error), env *Environment, pos SEPos) (RTValue, error) {
+	environment.mutex.Lock()
+	defer environment.mutex.Unlock()
+
+	v, ok := environment.vars[name]
+	if !ok {
+		if environment.Parent != nil {
+			return environment.Parent.Update(name, update, env, pos)
+		}
+
+		return nil, NewRuntimeError(
+			UNDEFINED_VARIABLE_ERROR,
+			fmt.Sprintf("a variable with the name of '%s' could not be found", name),
+			"",
+			pos,
+			env,
+		)
+	}
+
+	if v.Constant {
+		return nil, NewRuntimeError(
+			CONSTANT_VARIABLE_ASSIGNMENT_ERROR,
+			fmt.Sprintf("the variable '%s' is a constant and can therefor not be assigned to", name),
+			name,
+			pos,
+			environment,
+		)
+	}
+
+	value, err := update(v.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	environment.vars[name] = variable{
+		Value:          value,
+		Constant:       false,
+		DeclarationPos: v.DeclarationPos,
+	}
+
+	return value, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 type SnowError struct {
@@ -23,15 +24,86 @@ func NewSnowError(errType SnowErrType, msg string, tip string, pos SEPos) *SnowE
 }
 
 func (err SnowError) Error() string {
+	return err.format("\033[31m")
+}
+
+func (err SnowError) Warning() string {
+	return err.format("\033[33m")
+}
+
+func (err SnowError) format(color string) string {
 	tip := err.Tip
 	if tip != "" {
 		tip = tip + "\n"
 	}
 
-	codeAtLine := strings.Split(err.Pos.File.Code, "\n")[err.Pos.Start.Ln-1]
-	add := len(strconv.Itoa(err.Pos.Start.Ln+1)) + 3
-	arrows := strings.Repeat(" ", err.Pos.Start.Col+add) + strings.Repeat("^", err.Pos.End.Col-err.Pos.Start.Col+1)
-	return fmt.Sprintf("\033[31m%s\033[0m: %s\n%s%d | %s\n%s", err.ErrType, err.Msg, tip, err.Pos.Start.Ln, codeAtLine, arrows)
+	codeAtLine := err.Pos.File.Line(err.Pos.Start.Ln)
+	return fmt.Sprintf("%s%s\033[0m: %s\n%s%d | %s\n%s", color, err.ErrType, err.Msg, tip, err.Pos.Start.Ln, codeAtLine, err.arrows(codeAtLine))
+}
+
+func (err SnowError) arrows(codeAtLine string) string {
+	runes := []rune(codeAtLine)
+
+	start, end := err.Pos.Start.Col, err.Pos.End.Col+1
+	if err.Pos.End.Ln != err.Pos.Start.Ln {
+		end = len(runes)
+	}
+
+	padding := len(strconv.Itoa(err.Pos.Start.Ln)) + len(" | ")
+	if start > len(runes) {
+		padding += displayWidth(runes) + start - len(runes)
+		start = len(runes)
+	} else {
+		padding += displayWidth(runes[:start])
+	}
+
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	width := 1
+	if end > start {
+		width = displayWidth(runes[start:end])
+	}
+
+	if width < 1 {
+		width = 1
+	}
+
+	return strings.Repeat(" ", padding) + strings.Repeat("^", width)
+}
+
+func displayWidth(runes []rune) int {
+	width := 0
+	for _, r := range runes {
+		width += runeWidth(r)
+	}
+
+	return width
+}
+
+func runeWidth(r rune) int {
+	if unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) {
+		return 0
+	}
+
+	if r >= 0x1100 && (r <= 0x115f ||
+		r == 0x2329 || r == 0x232a ||
+		(r >= 0x2e80 && r <= 0xa4cf && r != 0x303f) ||
+		(r >= 0xac00 && r <= 0xd7a3) ||
+		(r >= 0xf900 && r <= 0xfaff) ||
+		(r >= 0xfe10 && r <= 0xfe19) ||
+		(r >= 0xfe30 && r <= 0xfe6f) ||
+		(r >= 0xff00 && r <= 0xff60) ||
+		(r >= 0xffe0 && r <= 0xffe6) ||
+		(r >= 0x1f300 && r <= 0x1f64f) ||
+		(r >= 0x1f900 && r <= 0x1f9ff) ||
+		(r >= 0x20000 && r <= 0x2fffd) ||
+		(r >= 0x30000 && r <= 0x3fffd)) {
+		return 2
+	}
+
+	return 1
 }
 
 func NewUnexpectedTokenError(expected TokenType, got Token) *SnowError {
@@ -15,7 +15,7 @@ const (
 	VALUE_ERROR                        SnowErrType = "Value error"
 	DIVISION_BY_ZERO_ERROR             SnowErrType = "Division by zero error"
 	UNEXPECTED_TOKEN_ERROR             SnowErrType = "Unexpected token error"
-	VARIABLE_ALREADY_DECLARED_ERROR    SnowErrType = "Unexpected token error"
+	VARIABLE_ALREADY_DECLARED_ERROR    SnowErrType = "Variable already declared error"
 	UNDEFINED_VARIABLE_ERROR           SnowErrType = "Undefined variable error"
 	CONSTANT_VARIABLE_ASSIGNMENT_ERROR SnowErrType = "Constant variable assignment error"
 	INVALID_ATTRIBUTE_ERROR            SnowErrType = "Invalid attribute error"
@@ -25,4 +25,68 @@ const (
 	CONTINUE_OUTSIDE_OF_LOOP_ERROR     SnowErrType = "Continue outside of loop error"
 	INVALID_CALL_ERROR                 SnowErrType = "Invalid call error"
 	ARGUMENT_ERROR                     SnowErrType = "Argument error"
+	NOT_INDEXABLE_ERROR                SnowErrType = "Not indexable error"
+	INVALID_INDEX_ERROR                SnowErrType = "Invalid index error"
+	INDEX_OUT_OF_RANGE_ERROR           SnowErrType = "Index out of range error"
+	NOT_SLICEABLE_ERROR                SnowErrType = "Not sliceable error"
+	UNHASHABLE_ERROR                   SnowErrType = "Unhashable error"
+	KEY_ERROR                          SnowErrType = "Key error"
+	THIS_OUTSIDE_OF_CLASS_ERROR        SnowErrType = "This outside of class error"
+	SUPER_OUTSIDE_OF_CLASS_ERROR       SnowErrType = "Super outside of class error"
+	INVALID_SUPERCLASS_ERROR           SnowErrType = "Invalid superclass error"
+	NOT_ITERABLE_ERROR                 SnowErrType = "Not iterable error"
+	INVALID_ASSIGNMENT_TARGET_ERROR    SnowErrType = "Invalid assignment target error"
+	INVALID_PATTERN_ERROR              SnowErrType = "Invalid pattern error"
+	NON_EXHAUSTIVE_MATCH_WARNING       SnowErrType = "Non exhaustive match warning"
+	THROWN_ERROR                       SnowErrType = "Thrown error"
+	INVALID_CATCH_TYPE_ERROR           SnowErrType = "Invalid catch type error"
+	MISSING_CATCH_ERROR                SnowErrType = "Missing catch error"
+	IMPORT_ERROR                       SnowErrType = "Import error"
+	IMPORT_CYCLE_ERROR                 SnowErrType = "Import cycle error"
+	INVALID_PARAMETER_ERROR            SnowErrType = "Invalid parameter error"
+	INVALID_ESCAPE_ERROR               SnowErrType = "Invalid escape error"
+	INVALID_DIGIT_ERROR                SnowErrType = "Invalid digit error"
+	MISSING_DIGITS_ERROR               SnowErrType = "Missing digits error"
+	INVALID_SEPARATOR_ERROR            SnowErrType = "Invalid separator error"
+	DESTRUCTURING_ERROR                SnowErrType = "Destructuring error"
+	TYPE_ERROR                         SnowErrType = "Type error"
+	YIELD_OUTSIDE_OF_FUNCTION_ERROR    SnowErrType = "Yield outside of function error"
+	GENERATOR_ERROR                    SnowErrType = "Generator error"
+	GENERATOR_EXHAUSTED_ERROR          SnowErrType = "Generator exhausted error"
+	INVALID_SPAWN_ERROR                SnowErrType = "Invalid spawn error"
+	INVALID_SELECT_CASE_ERROR          SnowErrType = "Invalid select case error"
+	CHANNEL_ERROR                      SnowErrType = "Channel error"
+	INVALID_LABEL_ERROR                SnowErrType = "Invalid label error"
+	UNDEFINED_LABEL_ERROR              SnowErrType = "Undefined label error"
 )
+
+var RuntimeErrTypes = map[string]SnowErrType{
+	"INVALID_OP_TOKEN_ERROR":             INVALID_OP_TOKEN_ERROR,
+	"VALUE_ERROR":                        VALUE_ERROR,
+	"TOO_BIG_VALUE_ERROR":                TOO_BIG_VALUE_ERROR,
+	"DIVISION_BY_ZERO_ERROR":             DIVISION_BY_ZERO_ERROR,
+	"VARIABLE_ALREADY_DECLARED_ERROR":    VARIABLE_ALREADY_DECLARED_ERROR,
+	"UNDEFINED_VARIABLE_ERROR":           UNDEFINED_VARIABLE_ERROR,
+	"CONSTANT_VARIABLE_ASSIGNMENT_ERROR": CONSTANT_VARIABLE_ASSIGNMENT_ERROR,
+	"INVALID_ATTRIBUTE_ERROR":            INVALID_ATTRIBUTE_ERROR,
+	"UNABLE_TO_ASSIGN_ATTRIBUTE_ERROR":   UNABLE_TO_ASSIGN_ATTRIBUTE_ERROR,
+	"INVALID_CALL_ERROR":                 INVALID_CALL_ERROR,
+	"ARGUMENT_ERROR":                     ARGUMENT_ERROR,
+	"NOT_INDEXABLE_ERROR":                NOT_INDEXABLE_ERROR,
+	"INVALID_INDEX_ERROR":                INVALID_INDEX_ERROR,
+	"INDEX_OUT_OF_RANGE_ERROR":           INDEX_OUT_OF_RANGE_ERROR,
+	"NOT_SLICEABLE_ERROR":                NOT_SLICEABLE_ERROR,
+	"UNHASHABLE_ERROR":                   UNHASHABLE_ERROR,
+	"KEY_ERROR":                          KEY_ERROR,
+	"INVALID_SUPERCLASS_ERROR":           INVALID_SUPERCLASS_ERROR,
+	"NOT_ITERABLE_ERROR":                 NOT_ITERABLE_ERROR,
+	"INVALID_PATTERN_ERROR":              INVALID_PATTERN_ERROR,
+	"THROWN_ERROR":                       THROWN_ERROR,
+	"INVALID_CATCH_TYPE_ERROR":           INVALID_CATCH_TYPE_ERROR,
+	"IMPORT_ERROR":                       IMPORT_ERROR,
+	"IMPORT_CYCLE_ERROR":                 IMPORT_CYCLE_ERROR,
+	"DESTRUCTURING_ERROR":                DESTRUCTURING_ERROR,
+	"GENERATOR_ERROR":                    GENERATOR_ERROR,
+	"GENERATOR_EXHAUSTED_ERROR":          GENERATOR_EXHAUSTED_ERROR,
+	"CHANNEL_ERROR":                      CHANNEL_ERROR,
+}
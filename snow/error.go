@@ -0,0 +1,289 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type RTErrorValue struct {
+	Err         *RTError
+	Pos         SEPos
+	Environment *Environment
+}
+
+func NewRTErrorValue(err *RTError, pos SEPos, env *Environment) *RTErrorValue {
+	return &RTErrorValue{
+		Err:         err,
+		Pos:         pos,
+		Environment: env,
+	}
+}
+
+func (rTErrorValue *RTErrorValue) ToString() string {
+	return fmt.Sprintf("(ERROR: %s %s)", rTErrorValue.Err.ErrType, rTErrorValue.Err.Msg)
+}
+
+func (rTErrorValue *RTErrorValue) ValueToString() string {
+	return fmt.Sprintf("%s: %s", rTErrorValue.Err.ErrType, rTErrorValue.Err.Msg)
+}
+
+func (rTErrorValue *RTErrorValue) GetType() RTType {
+	return RTT_ERROR
+}
+
+func (rTErrorValue *RTErrorValue) GetValue() interface{} {
+	return rTErrorValue.Err
+}
+
+func (rTErrorValue *RTErrorValue) GetEnvironment() *Environment {
+	return rTErrorValue.Environment
+}
+
+func (rTErrorValue *RTErrorValue) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "type":
+		return NewRTString(position, string(rTErrorValue.Err.ErrType), rTErrorValue.Environment), nil
+	case "message":
+		return NewRTString(position, rTErrorValue.Err.Msg, rTErrorValue.Environment), nil
+	case "tip":
+		return NewRTString(position, rTErrorValue.Err.Tip, rTErrorValue.Environment), nil
+	case "line":
+		return NewRTInt(position, rTErrorValue.Err.Pos.Start.Ln, rTErrorValue.Environment), nil
+	case "stack":
+		stack := make([]RTValue, 0)
+		for _, frame := range rTErrorValue.Err.Stack() {
+			stack = append(stack, NewRTString(position, frame, rTErrorValue.Environment))
+		}
+
+		return NewRTList(position, stack, rTErrorValue.Environment), nil
+	case "value":
+		if rTErrorValue.Err.value == nil {
+			return NewRTNull(), nil
+		}
+
+		return rTErrorValue.Err.value, nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTErrorValue, other, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTErrorValue, other, value, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTErrorValue, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTErrorValue, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTErrorValue, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTErrorValue, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTErrorValue, position, rTErrorValue.Environment)
+}
+
+func (rTErrorValue *RTErrorValue) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Equals(other RTValue, position SEPos) (RTValue, error) {
+	if other.GetType() == RTT_ERROR {
+		return NewRTBool(position, rTErrorValue.Err == other.(*RTErrorValue).Err, rTErrorValue.Environment), nil
+	}
+
+	return NewRTBool(position, false, rTErrorValue.Environment), nil
+}
+
+func (rTErrorValue *RTErrorValue) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	if other.GetType() == RTT_ERROR {
+		return NewRTBool(position, rTErrorValue.Err != other.(*RTErrorValue).Err, rTErrorValue.Environment), nil
+	}
+
+	return NewRTBool(position, true, rTErrorValue.Environment), nil
+}
+
+func (rTErrorValue *RTErrorValue) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTErrorValue,
+		other,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTErrorValue.Environment), nil
+}
+
+func (rTErrorValue *RTErrorValue) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTErrorValue,
+		nil,
+		position,
+		rTErrorValue.Environment,
+	)
+}
+
+func (rTErrorValue *RTErrorValue) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTErrorValue.Environment), nil
+}
+
+func (rTErrorValue *RTErrorValue) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTErrorValue, position, rTErrorValue.Environment)
+}
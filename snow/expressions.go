@@ -2,6 +2,7 @@ package snow
 
 import (
 	"fmt"
+	"math/big"
 )
 
 type Expr interface {
@@ -12,15 +13,31 @@ type Expr interface {
 
 type ExprVisitor interface {
 	VisitBinaryExpr(expr BinaryExpr, env *Environment) (RTValue, error)
+	VisitLogicalExpr(expr LogicalExpr, env *Environment) (RTValue, error)
 	VisitUnaryExpr(expr UnaryExpr, env *Environment) (RTValue, error)
 	VisitGroupingExpr(expr GroupingExpr, env *Environment) (RTValue, error)
 	VisitIntLiteralExpr(expr IntLiteralExpr, env *Environment) (RTValue, error)
 	VisitFloatLiteralExpr(expr FloatLiteralExpr, env *Environment) (RTValue, error)
 	VisitBoolLiteralExpr(expr BoolLiteralExpr, env *Environment) (RTValue, error)
+	VisitStringLiteralExpr(expr StringLiteralExpr, env *Environment) (RTValue, error)
+	VisitNullLiteralExpr(expr NullLiteralExpr, env *Environment) (RTValue, error)
 	VisitVarAccessExpr(expr VarAccessExpr, env *Environment) (RTValue, error)
 	VisitVarAssignmentExpr(expr VarAssignmentExpr, env *Environment) (RTValue, error)
 	VisitDotExpr(expr DotExpr, env *Environment) (RTValue, error)
 	VisitCallExpr(expr CallExpr, env *Environment) (RTValue, error)
+	VisitListLiteralExpr(expr ListLiteralExpr, env *Environment) (RTValue, error)
+	VisitTupleLiteralExpr(expr TupleLiteralExpr, env *Environment) (RTValue, error)
+	VisitIndexExpr(expr IndexExpr, env *Environment) (RTValue, error)
+	VisitSliceExpr(expr SliceExpr, env *Environment) (RTValue, error)
+	VisitIndexAssignmentExpr(expr IndexAssignmentExpr, env *Environment) (RTValue, error)
+	VisitMapLiteralExpr(expr MapLiteralExpr, env *Environment) (RTValue, error)
+	VisitThisExpr(expr ThisExpr, env *Environment) (RTValue, error)
+	VisitSuperExpr(expr SuperExpr, env *Environment) (RTValue, error)
+	VisitFunctionExpr(expr FunctionExpr, env *Environment) (RTValue, error)
+	VisitConditionalExpr(expr ConditionalExpr, env *Environment) (RTValue, error)
+	VisitStringifyExpr(expr StringifyExpr, env *Environment) (RTValue, error)
+	VisitSpawnExpr(expr SpawnExpr, env *Environment) (RTValue, error)
+	VisitAwaitExpr(expr AwaitExpr, env *Environment) (RTValue, error)
 }
 
 type BinaryExpr struct {
@@ -51,6 +68,34 @@ func (binaryExpr BinaryExpr) GetPosition() SEPos {
 	return binaryExpr.Pos
 }
 
+type LogicalExpr struct {
+	Left  Expr
+	Right Expr
+	Tok   Token
+	Pos   SEPos
+}
+
+func NewLogicalExpr(left Expr, right Expr, tok Token, pos SEPos) *LogicalExpr {
+	return &LogicalExpr{
+		Left:  left,
+		Right: right,
+		Tok:   tok,
+		Pos:   pos,
+	}
+}
+
+func (logicalExpr LogicalExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitLogicalExpr(logicalExpr, env)
+}
+
+func (logicalExpr LogicalExpr) ToString() string {
+	return fmt.Sprintf("(%s %s %s)", logicalExpr.Left.ToString(), logicalExpr.Tok.ToString(), logicalExpr.Right.ToString())
+}
+
+func (logicalExpr LogicalExpr) GetPosition() SEPos {
+	return logicalExpr.Pos
+}
+
 type UnaryExpr struct {
 	Tok   Token
 	Right Expr
@@ -103,6 +148,7 @@ func (groupingExpr GroupingExpr) GetPosition() SEPos {
 
 type IntLiteralExpr struct {
 	Value int
+	Big   *big.Int
 	Pos   SEPos
 }
 
@@ -113,11 +159,22 @@ func NewIntLiteralExpr(value int, pos SEPos) *IntLiteralExpr {
 	}
 }
 
+func NewBigIntLiteralExpr(value *big.Int, pos SEPos) *IntLiteralExpr {
+	return &IntLiteralExpr{
+		Big: value,
+		Pos: pos,
+	}
+}
+
 func (intLiteralExpr IntLiteralExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
 	return visitor.VisitIntLiteralExpr(intLiteralExpr, env)
 }
 
 func (intLiteralExpr IntLiteralExpr) ToString() string {
+	if intLiteralExpr.Big != nil {
+		return fmt.Sprintf("(INT: %s)", intLiteralExpr.Big.String())
+	}
+
 	return fmt.Sprintf("(INT: %d)", intLiteralExpr.Value)
 }
 
@@ -173,6 +230,52 @@ func (boolLiteralExpr BoolLiteralExpr) GetPosition() SEPos {
 	return boolLiteralExpr.Pos
 }
 
+type StringLiteralExpr struct {
+	Value string
+	Pos   SEPos
+}
+
+func NewStringLiteralExpr(value string, pos SEPos) *StringLiteralExpr {
+	return &StringLiteralExpr{
+		Value: value,
+		Pos:   pos,
+	}
+}
+
+func (stringLiteralExpr StringLiteralExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitStringLiteralExpr(stringLiteralExpr, env)
+}
+
+func (stringLiteralExpr StringLiteralExpr) ToString() string {
+	return fmt.Sprintf("(STRING: \"%s\")", stringLiteralExpr.Value)
+}
+
+func (stringLiteralExpr StringLiteralExpr) GetPosition() SEPos {
+	return stringLiteralExpr.Pos
+}
+
+type NullLiteralExpr struct {
+	Pos SEPos
+}
+
+func NewNullLiteralExpr(pos SEPos) *NullLiteralExpr {
+	return &NullLiteralExpr{
+		Pos: pos,
+	}
+}
+
+func (nullLiteralExpr NullLiteralExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitNullLiteralExpr(nullLiteralExpr, env)
+}
+
+func (nullLiteralExpr NullLiteralExpr) ToString() string {
+	return "(NULL)"
+}
+
+func (nullLiteralExpr NullLiteralExpr) GetPosition() SEPos {
+	return nullLiteralExpr.Pos
+}
+
 type VarAccessExpr struct {
 	Value string
 	Pos   SEPos
@@ -198,18 +301,31 @@ func (varAccessExpr VarAccessExpr) GetPosition() SEPos {
 }
 
 type VarAssignmentExpr struct {
-	Object Expr
-	Name   string
-	Value  Expr
-	Pos    SEPos
+	Object  Expr
+	Name    string
+	Pattern Pattern
+	Value   Expr
+	Op      *Token
+	Postfix bool
+	Pos     SEPos
 }
 
-func NewVarAssignmentExpr(object Expr, name string, value Expr, pos SEPos) *VarAssignmentExpr {
+func NewVarAssignmentExpr(object Expr, name string, value Expr, op *Token, postfix bool, pos SEPos) *VarAssignmentExpr {
 	return &VarAssignmentExpr{
-		Object: object,
-		Name:   name,
-		Value:  value,
-		Pos:    pos,
+		Object:  object,
+		Name:    name,
+		Value:   value,
+		Op:      op,
+		Postfix: postfix,
+		Pos:     pos,
+	}
+}
+
+func NewDestructuringAssignmentExpr(pattern Pattern, value Expr, pos SEPos) *VarAssignmentExpr {
+	return &VarAssignmentExpr{
+		Pattern: pattern,
+		Value:   value,
+		Pos:     pos,
 	}
 }
 
@@ -218,6 +334,12 @@ func (varAssignmentExpr VarAssignmentExpr) Accept(visitor ExprVisitor, env *Envi
 }
 
 func (varAssignmentExpr VarAssignmentExpr) ToString() string {
+	if varAssignmentExpr.Pattern != nil {
+		return fmt.Sprintf("(VAR_ASSIGNMENT_EXPR: %s = %s)", varAssignmentExpr.Pattern.ToString(), varAssignmentExpr.Value.ToString())
+	} else if varAssignmentExpr.Object == nil {
+		return fmt.Sprintf("(VAR_ASSIGNMENT_EXPR: %s = %s)", varAssignmentExpr.Name, varAssignmentExpr.Value.ToString())
+	}
+
 	return fmt.Sprintf("(VAR_ASSIGNMENT_EXPR: %s . %s = %s)", varAssignmentExpr.Object.ToString(), varAssignmentExpr.Name, varAssignmentExpr.Value.ToString())
 }
 
@@ -251,13 +373,37 @@ func (dotExpr DotExpr) GetPosition() SEPos {
 	return dotExpr.Pos
 }
 
+type Argument struct {
+	Name   *Token
+	Value  Expr
+	Spread bool
+}
+
+func NewArgument(name *Token, value Expr, spread bool) *Argument {
+	return &Argument{
+		Name:   name,
+		Value:  value,
+		Spread: spread,
+	}
+}
+
+func (argument Argument) ToString() string {
+	if argument.Spread {
+		return fmt.Sprintf("(ARGUMENT: ...%s)", argument.Value.ToString())
+	} else if argument.Name != nil {
+		return fmt.Sprintf("(ARGUMENT: %s: %s)", argument.Name.Value, argument.Value.ToString())
+	}
+
+	return fmt.Sprintf("(ARGUMENT: %s)", argument.Value.ToString())
+}
+
 type CallExpr struct {
 	Function  Expr
-	Arguments []Expr
+	Arguments []Argument
 	Pos       SEPos
 }
 
-func NewCallExpr(function Expr, arguments []Expr, pos SEPos) *CallExpr {
+func NewCallExpr(function Expr, arguments []Argument, pos SEPos) *CallExpr {
 	return &CallExpr{
 		Function:  function,
 		Arguments: arguments,
@@ -276,3 +422,377 @@ func (callExpr CallExpr) ToString() string {
 func (callExpr CallExpr) GetPosition() SEPos {
 	return callExpr.Pos
 }
+
+type ListLiteralExpr struct {
+	Elements []Expr
+	Pos      SEPos
+}
+
+func NewListLiteralExpr(elements []Expr, pos SEPos) *ListLiteralExpr {
+	return &ListLiteralExpr{
+		Elements: elements,
+		Pos:      pos,
+	}
+}
+
+func (listLiteralExpr ListLiteralExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitListLiteralExpr(listLiteralExpr, env)
+}
+
+func (listLiteralExpr ListLiteralExpr) ToString() string {
+	s := "["
+	for _, v := range listLiteralExpr.Elements {
+		s += v.ToString() + " "
+	}
+	s += "]"
+
+	return fmt.Sprintf("(LIST: %s)", s)
+}
+
+func (listLiteralExpr ListLiteralExpr) GetPosition() SEPos {
+	return listLiteralExpr.Pos
+}
+
+type TupleLiteralExpr struct {
+	Elements []Expr
+	Pos      SEPos
+}
+
+func NewTupleLiteralExpr(elements []Expr, pos SEPos) *TupleLiteralExpr {
+	return &TupleLiteralExpr{
+		Elements: elements,
+		Pos:      pos,
+	}
+}
+
+func (tupleLiteralExpr TupleLiteralExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitTupleLiteralExpr(tupleLiteralExpr, env)
+}
+
+func (tupleLiteralExpr TupleLiteralExpr) ToString() string {
+	s := "("
+	for _, v := range tupleLiteralExpr.Elements {
+		s += v.ToString() + " "
+	}
+	s += ")"
+
+	return fmt.Sprintf("(TUPLE: %s)", s)
+}
+
+func (tupleLiteralExpr TupleLiteralExpr) GetPosition() SEPos {
+	return tupleLiteralExpr.Pos
+}
+
+type IndexExpr struct {
+	Object Expr
+	Index  Expr
+	Pos    SEPos
+}
+
+func NewIndexExpr(object Expr, index Expr, pos SEPos) *IndexExpr {
+	return &IndexExpr{
+		Object: object,
+		Index:  index,
+		Pos:    pos,
+	}
+}
+
+func (indexExpr IndexExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitIndexExpr(indexExpr, env)
+}
+
+func (indexExpr IndexExpr) ToString() string {
+	return fmt.Sprintf("(INDEX_EXPR: %s [ %s ])", indexExpr.Object.ToString(), indexExpr.Index.ToString())
+}
+
+func (indexExpr IndexExpr) GetPosition() SEPos {
+	return indexExpr.Pos
+}
+
+type SliceExpr struct {
+	Object Expr
+	Start  Expr
+	End    Expr
+	Pos    SEPos
+}
+
+func NewSliceExpr(object Expr, start Expr, end Expr, pos SEPos) *SliceExpr {
+	return &SliceExpr{
+		Object: object,
+		Start:  start,
+		End:    end,
+		Pos:    pos,
+	}
+}
+
+func (sliceExpr SliceExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitSliceExpr(sliceExpr, env)
+}
+
+func (sliceExpr SliceExpr) ToString() string {
+	start := ""
+	if sliceExpr.Start != nil {
+		start = sliceExpr.Start.ToString()
+	}
+
+	end := ""
+	if sliceExpr.End != nil {
+		end = sliceExpr.End.ToString()
+	}
+
+	return fmt.Sprintf("(SLICE_EXPR: %s [ %s : %s ])", sliceExpr.Object.ToString(), start, end)
+}
+
+func (sliceExpr SliceExpr) GetPosition() SEPos {
+	return sliceExpr.Pos
+}
+
+type IndexAssignmentExpr struct {
+	Object  Expr
+	Index   Expr
+	Value   Expr
+	Op      *Token
+	Postfix bool
+	Pos     SEPos
+}
+
+func NewIndexAssignmentExpr(object Expr, index Expr, value Expr, op *Token, postfix bool, pos SEPos) *IndexAssignmentExpr {
+	return &IndexAssignmentExpr{
+		Object:  object,
+		Index:   index,
+		Value:   value,
+		Op:      op,
+		Postfix: postfix,
+		Pos:     pos,
+	}
+}
+
+func (indexAssignmentExpr IndexAssignmentExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitIndexAssignmentExpr(indexAssignmentExpr, env)
+}
+
+func (indexAssignmentExpr IndexAssignmentExpr) ToString() string {
+	return fmt.Sprintf("(INDEX_ASSIGNMENT_EXPR: %s [ %s ] = %s)", indexAssignmentExpr.Object.ToString(), indexAssignmentExpr.Index.ToString(), indexAssignmentExpr.Value.ToString())
+}
+
+func (indexAssignmentExpr IndexAssignmentExpr) GetPosition() SEPos {
+	return indexAssignmentExpr.Pos
+}
+
+type MapLiteralExpr struct {
+	Keys   []Expr
+	Values []Expr
+	Pos    SEPos
+}
+
+func NewMapLiteralExpr(keys []Expr, values []Expr, pos SEPos) *MapLiteralExpr {
+	return &MapLiteralExpr{
+		Keys:   keys,
+		Values: values,
+		Pos:    pos,
+	}
+}
+
+func (mapLiteralExpr MapLiteralExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitMapLiteralExpr(mapLiteralExpr, env)
+}
+
+func (mapLiteralExpr MapLiteralExpr) ToString() string {
+	s := "{"
+	for index := range mapLiteralExpr.Keys {
+		s += mapLiteralExpr.Keys[index].ToString() + ": " + mapLiteralExpr.Values[index].ToString() + " "
+	}
+	s += "}"
+
+	return fmt.Sprintf("(MAP: %s)", s)
+}
+
+func (mapLiteralExpr MapLiteralExpr) GetPosition() SEPos {
+	return mapLiteralExpr.Pos
+}
+
+type ThisExpr struct {
+	Pos SEPos
+}
+
+func NewThisExpr(pos SEPos) *ThisExpr {
+	return &ThisExpr{
+		Pos: pos,
+	}
+}
+
+func (thisExpr ThisExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitThisExpr(thisExpr, env)
+}
+
+func (thisExpr ThisExpr) ToString() string {
+	return "(THIS)"
+}
+
+func (thisExpr ThisExpr) GetPosition() SEPos {
+	return thisExpr.Pos
+}
+
+type SuperExpr struct {
+	Method Token
+	Pos    SEPos
+}
+
+func NewSuperExpr(method Token, pos SEPos) *SuperExpr {
+	return &SuperExpr{
+		Method: method,
+		Pos:    pos,
+	}
+}
+
+func (superExpr SuperExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitSuperExpr(superExpr, env)
+}
+
+func (superExpr SuperExpr) ToString() string {
+	return fmt.Sprintf("(SUPER: %s)", superExpr.Method.ToString())
+}
+
+func (superExpr SuperExpr) GetPosition() SEPos {
+	return superExpr.Pos
+}
+
+type FunctionExpr struct {
+	Parameters []Parameter
+	ReturnType *TypeAnnotation
+	Block      *BlockStmt
+	Generator  bool
+	Pos        SEPos
+}
+
+func NewFunctionExpr(parameters []Parameter, returnType *TypeAnnotation, block *BlockStmt, generator bool, pos SEPos) *FunctionExpr {
+	return &FunctionExpr{
+		Parameters: parameters,
+		ReturnType: returnType,
+		Block:      block,
+		Generator:  generator,
+		Pos:        pos,
+	}
+}
+
+func (functionExpr FunctionExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitFunctionExpr(functionExpr, env)
+}
+
+func (functionExpr FunctionExpr) ToString() string {
+	p := "["
+	for _, param := range functionExpr.Parameters {
+		p += param.ToString() + " "
+	}
+	p += "]"
+
+	if functionExpr.ReturnType != nil {
+		return fmt.Sprintf("(FUNCTION_EXPR: %s %s %s)", p, functionExpr.ReturnType.ToString(), functionExpr.Block.ToString())
+	}
+
+	return fmt.Sprintf("(FUNCTION_EXPR: %s %s)", p, functionExpr.Block.ToString())
+}
+
+func (functionExpr FunctionExpr) GetPosition() SEPos {
+	return functionExpr.Pos
+}
+
+type ConditionalExpr struct {
+	Condition Expr
+	Then      Expr
+	Else      Expr
+	Pos       SEPos
+}
+
+func NewConditionalExpr(condition Expr, then Expr, elseExpr Expr, pos SEPos) *ConditionalExpr {
+	return &ConditionalExpr{
+		Condition: condition,
+		Then:      then,
+		Else:      elseExpr,
+		Pos:       pos,
+	}
+}
+
+func (conditionalExpr ConditionalExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitConditionalExpr(conditionalExpr, env)
+}
+
+func (conditionalExpr ConditionalExpr) ToString() string {
+	return fmt.Sprintf("(CONDITIONAL_EXPR: %s ? %s : %s)", conditionalExpr.Condition.ToString(), conditionalExpr.Then.ToString(), conditionalExpr.Else.ToString())
+}
+
+func (conditionalExpr ConditionalExpr) GetPosition() SEPos {
+	return conditionalExpr.Pos
+}
+
+type StringifyExpr struct {
+	Value Expr
+	Pos   SEPos
+}
+
+func NewStringifyExpr(value Expr, pos SEPos) *StringifyExpr {
+	return &StringifyExpr{
+		Value: value,
+		Pos:   pos,
+	}
+}
+
+func (stringifyExpr StringifyExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitStringifyExpr(stringifyExpr, env)
+}
+
+func (stringifyExpr StringifyExpr) ToString() string {
+	return fmt.Sprintf("(STRINGIFY_EXPR: %s)", stringifyExpr.Value.ToString())
+}
+
+func (stringifyExpr StringifyExpr) GetPosition() SEPos {
+	return stringifyExpr.Pos
+}
+
+type SpawnExpr struct {
+	Call *CallExpr
+	Pos  SEPos
+}
+
+func NewSpawnExpr(call *CallExpr, pos SEPos) *SpawnExpr {
+	return &SpawnExpr{
+		Call: call,
+		Pos:  pos,
+	}
+}
+
+func (spawnExpr SpawnExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitSpawnExpr(spawnExpr, env)
+}
+
+func (spawnExpr SpawnExpr) ToString() string {
+	return fmt.Sprintf("(SPAWN: %s)", spawnExpr.Call.ToString())
+}
+
+func (spawnExpr SpawnExpr) GetPosition() SEPos {
+	return spawnExpr.Pos
+}
+
+type AwaitExpr struct {
+	Value Expr
+	Pos   SEPos
+}
+
+func NewAwaitExpr(value Expr, pos SEPos) *AwaitExpr {
+	return &AwaitExpr{
+		Value: value,
+		Pos:   pos,
+	}
+}
+
+func (awaitExpr AwaitExpr) Accept(visitor ExprVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitAwaitExpr(awaitExpr, env)
+}
+
+func (awaitExpr AwaitExpr) ToString() string {
+	return fmt.Sprintf("(AWAIT: %s)", awaitExpr.Value.ToString())
+}
+
+func (awaitExpr AwaitExpr) GetPosition() SEPos {
+	return awaitExpr.Pos
+}
@@ -1,13 +1,41 @@
 package snow
 
+import (
+	"strings"
+)
+
+const DefaultTabWidth = 4
+
 type File struct {
-	Name string
-	Code string
+	Name     string
+	Code     string
+	TabWidth int
 }
 
 func NewFile(name string, code string) *File {
 	return &File{
-		Name: name,
-		Code: code,
+		Name:     name,
+		Code:     code,
+		TabWidth: DefaultTabWidth,
 	}
 }
+
+func (file *File) Line(ln int) string {
+	line := strings.Split(file.Code, "\n")[ln-1]
+	line = strings.TrimSuffix(line, "\r")
+
+	var builder strings.Builder
+	col := 0
+	for _, c := range line {
+		if c == '\t' {
+			width := file.TabWidth - col%file.TabWidth
+			builder.WriteString(strings.Repeat(" ", width))
+			col += width
+		} else {
+			builder.WriteRune(c)
+			col++
+		}
+	}
+
+	return builder.String()
+}
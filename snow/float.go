@@ -2,6 +2,8 @@ package snow
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 )
 
 type RTFloat struct {
@@ -46,12 +48,38 @@ func (rTFloat *RTFloat) SetAttribute(other string, value RTValue, position SEPos
 	return nil, NewUnableToAssignAttributeRTError(rTFloat, other, value, position, rTFloat.Environment)
 }
 
+func (rTFloat *RTFloat) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTFloat, position, rTFloat.Environment)
+}
+
+func (rTFloat *RTFloat) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTFloat, position, rTFloat.Environment)
+}
+
+func (rTFloat *RTFloat) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTFloat, position, rTFloat.Environment)
+}
+
+func (rTFloat *RTFloat) Hash(position SEPos) (string, error) {
+	if rTFloat.Value == math.Trunc(rTFloat.Value) && !math.IsInf(rTFloat.Value, 0) {
+		value, _ := big.NewFloat(rTFloat.Value).Int(nil)
+
+		return "n:" + value.String(), nil
+	}
+
+	return fmt.Sprintf("n:%v", rTFloat.Value), nil
+}
+
+func (rTFloat *RTFloat) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTFloat, position, rTFloat.Environment)
+}
+
 func (rTFloat *RTFloat) Add(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_FLOAT:
 		return NewRTFloat(position, rTFloat.Value+other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTFloat(position, rTFloat.Value+float64(other.GetValue().(int)), rTFloat.Environment), nil
+		return NewRTFloat(position, rTFloat.Value+other.(*RTInt).Float(), rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -68,7 +96,7 @@ func (rTFloat *RTFloat) Subtract(other RTValue, position SEPos) (RTValue, error)
 	case RTT_FLOAT:
 		return NewRTFloat(position, rTFloat.Value-other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTFloat(position, rTFloat.Value-float64(other.GetValue().(int)), rTFloat.Environment), nil
+		return NewRTFloat(position, rTFloat.Value-other.(*RTInt).Float(), rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -85,7 +113,7 @@ func (rTFloat *RTFloat) Multiply(other RTValue, position SEPos) (RTValue, error)
 	case RTT_FLOAT:
 		return NewRTFloat(position, rTFloat.Value*other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTFloat(position, rTFloat.Value*float64(other.GetValue().(int)), rTFloat.Environment), nil
+		return NewRTFloat(position, rTFloat.Value*other.(*RTInt).Float(), rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -106,11 +134,11 @@ func (rTFloat *RTFloat) Divide(other RTValue, position SEPos) (RTValue, error) {
 
 		return NewRTFloat(position, rTFloat.Value/other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		if other.GetValue().(int) == 0 {
+		if other.(*RTInt).isZero() {
 			return nil, NewDivisionByZeroRTError(rTFloat, other, position, rTFloat.Environment)
 		}
 
-		return NewRTFloat(position, rTFloat.Value/float64(other.GetValue().(int)), rTFloat.Environment), nil
+		return NewRTFloat(position, rTFloat.Value/other.(*RTInt).Float(), rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -122,12 +150,131 @@ func (rTFloat *RTFloat) Divide(other RTValue, position SEPos) (RTValue, error) {
 	)
 }
 
+func (rTFloat *RTFloat) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_FLOAT:
+		if other.GetValue().(float64) == 0 {
+			return nil, NewDivisionByZeroRTError(rTFloat, other, position, rTFloat.Environment)
+		}
+
+		return NewRTFloat(position, floatMod(rTFloat.Value, other.GetValue().(float64)), rTFloat.Environment), nil
+	case RTT_INT:
+		if other.(*RTInt).isZero() {
+			return nil, NewDivisionByZeroRTError(rTFloat, other, position, rTFloat.Environment)
+		}
+
+		return NewRTFloat(position, floatMod(rTFloat.Value, other.(*RTInt).Float()), rTFloat.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PERCENT,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) Power(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_FLOAT:
+		return NewRTFloat(position, math.Pow(rTFloat.Value, other.GetValue().(float64)), rTFloat.Environment), nil
+	case RTT_INT:
+		return NewRTFloat(position, math.Pow(rTFloat.Value, other.(*RTInt).Float()), rTFloat.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_FLOAT:
+		if other.GetValue().(float64) == 0 {
+			return nil, NewDivisionByZeroRTError(rTFloat, other, position, rTFloat.Environment)
+		}
+
+		return NewRTFloat(position, math.Floor(rTFloat.Value/other.GetValue().(float64)), rTFloat.Environment), nil
+	case RTT_INT:
+		if other.(*RTInt).isZero() {
+			return nil, NewDivisionByZeroRTError(rTFloat, other, position, rTFloat.Environment)
+		}
+
+		return NewRTFloat(position, math.Floor(rTFloat.Value/other.(*RTInt).Float()), rTFloat.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
+func (rTFloat *RTFloat) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTFloat,
+		other,
+		position,
+		rTFloat.Environment,
+	)
+}
+
 func (rTFloat *RTFloat) Equals(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_FLOAT:
 		return NewRTBool(position, rTFloat.Value == other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTBool(position, rTFloat.Value == float64(other.GetValue().(int)), rTFloat.Environment), nil
+		c, ok := other.(*RTInt).compareFloat(rTFloat.Value)
+
+		return NewRTBool(position, ok && c == 0, rTFloat.Environment), nil
 	}
 
 	return NewRTBool(position, false, rTFloat.Environment), nil
@@ -138,7 +285,9 @@ func (rTFloat *RTFloat) NotEquals(other RTValue, position SEPos) (RTValue, error
 	case RTT_FLOAT:
 		return NewRTBool(position, rTFloat.Value != other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTBool(position, rTFloat.Value != float64(other.GetValue().(int)), rTFloat.Environment), nil
+		c, ok := other.(*RTInt).compareFloat(rTFloat.Value)
+
+		return NewRTBool(position, !ok || c != 0, rTFloat.Environment), nil
 	}
 
 	return NewRTBool(position, true, rTFloat.Environment), nil
@@ -149,7 +298,9 @@ func (rTFloat *RTFloat) GreaterThan(other RTValue, position SEPos) (RTValue, err
 	case RTT_FLOAT:
 		return NewRTBool(position, rTFloat.Value > other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTBool(position, rTFloat.Value > float64(other.GetValue().(int)), rTFloat.Environment), nil
+		c, ok := other.(*RTInt).compareFloat(rTFloat.Value)
+
+		return NewRTBool(position, ok && c < 0, rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -166,7 +317,9 @@ func (rTFloat *RTFloat) GreaterThanEquals(other RTValue, position SEPos) (RTValu
 	case RTT_FLOAT:
 		return NewRTBool(position, rTFloat.Value >= other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTBool(position, rTFloat.Value >= float64(other.GetValue().(int)), rTFloat.Environment), nil
+		c, ok := other.(*RTInt).compareFloat(rTFloat.Value)
+
+		return NewRTBool(position, ok && c <= 0, rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -183,7 +336,9 @@ func (rTFloat *RTFloat) LessThan(other RTValue, position SEPos) (RTValue, error)
 	case RTT_FLOAT:
 		return NewRTBool(position, rTFloat.Value < other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTBool(position, rTFloat.Value < float64(other.GetValue().(int)), rTFloat.Environment), nil
+		c, ok := other.(*RTInt).compareFloat(rTFloat.Value)
+
+		return NewRTBool(position, ok && c > 0, rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -200,7 +355,9 @@ func (rTFloat *RTFloat) LessThanEquals(other RTValue, position SEPos) (RTValue,
 	case RTT_FLOAT:
 		return NewRTBool(position, rTFloat.Value <= other.GetValue().(float64), rTFloat.Environment), nil
 	case RTT_INT:
-		return NewRTBool(position, rTFloat.Value <= float64(other.GetValue().(int)), rTFloat.Environment), nil
+		c, ok := other.(*RTInt).compareFloat(rTFloat.Value)
+
+		return NewRTBool(position, ok && c >= 0, rTFloat.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -220,6 +377,16 @@ func (rTFloat *RTFloat) Not(position SEPos) (RTValue, error) {
 	return NewRTBool(position, false, rTFloat.Environment), nil
 }
 
+func (rTFloat *RTFloat) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTFloat,
+		nil,
+		position,
+		rTFloat.Environment,
+	)
+}
+
 func (rTFloat *RTFloat) ToBool(position SEPos) (RTValue, error) {
 	if rTFloat.Value == 0 {
 		return NewRTBool(position, false, rTFloat.Environment), nil
@@ -228,6 +395,15 @@ func (rTFloat *RTFloat) ToBool(position SEPos) (RTValue, error) {
 	return NewRTBool(position, true, rTFloat.Environment), nil
 }
 
-func (rTFloat *RTFloat) Call(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+func (rTFloat *RTFloat) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
 	return nil, NewInvalidCallRTError(rTFloat, position, rTFloat.Environment)
 }
+
+func floatMod(x float64, y float64) float64 {
+	remainder := math.Mod(x, y)
+	if remainder != 0 && (remainder < 0) != (y < 0) {
+		remainder += y
+	}
+
+	return remainder
+}
@@ -6,13 +6,15 @@ import (
 
 type RTFunction struct {
 	Name        string
-	Parameters  []Token
+	Parameters  []Parameter
 	Block       *BlockStmt
+	This        RTValue
+	Generator   bool
 	Pos         SEPos
 	Environment *Environment
 }
 
-func NewRTFunction(name string, parameters []Token, block *BlockStmt, pos SEPos, env *Environment) *RTFunction {
+func NewRTFunction(name string, parameters []Parameter, block *BlockStmt, pos SEPos, env *Environment) *RTFunction {
 	return &RTFunction{
 		Name:        name,
 		Parameters:  parameters,
@@ -22,6 +24,14 @@ func NewRTFunction(name string, parameters []Token, block *BlockStmt, pos SEPos,
 	}
 }
 
+func (rTFunction *RTFunction) Bind(this RTValue) *RTFunction {
+	bound := NewRTFunction(rTFunction.Name, rTFunction.Parameters, rTFunction.Block, rTFunction.Pos, rTFunction.Environment)
+	bound.This = this
+	bound.Generator = rTFunction.Generator
+
+	return bound
+}
+
 func (rTFunction *RTFunction) ToString() string {
 	return fmt.Sprintf("(FUNCTION: %s)", rTFunction.Name)
 }
@@ -50,6 +60,26 @@ func (rTFunction *RTFunction) SetAttribute(other string, value RTValue, position
 	return nil, NewUnableToAssignAttributeRTError(rTFunction, other, value, position, rTFunction.Environment)
 }
 
+func (rTFunction *RTFunction) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTFunction, position, rTFunction.Environment)
+}
+
+func (rTFunction *RTFunction) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTFunction, position, rTFunction.Environment)
+}
+
+func (rTFunction *RTFunction) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTFunction, position, rTFunction.Environment)
+}
+
+func (rTFunction *RTFunction) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTFunction, position, rTFunction.Environment)
+}
+
+func (rTFunction *RTFunction) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTFunction, position, rTFunction.Environment)
+}
+
 func (rTFunction *RTFunction) Add(other RTValue, position SEPos) (RTValue, error) {
 	return nil, NewValueRTError(
 		PLUS,
@@ -90,8 +120,88 @@ func (rTFunction *RTFunction) Divide(other RTValue, position SEPos) (RTValue, er
 	)
 }
 
+func (rTFunction *RTFunction) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
+func (rTFunction *RTFunction) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTFunction,
+		other,
+		position,
+		rTFunction.Environment,
+	)
+}
+
 func (rTFunction *RTFunction) Equals(other RTValue, position SEPos) (RTValue, error) {
-	if other.GetType() == RTT_FUNCTION && other.(*RTFunction).Name == rTFunction.Name && other.(*RTFunction).Environment == rTFunction.Environment {
+	if other.GetType() == RTT_FUNCTION && other.(*RTFunction).Name == rTFunction.Name && other.(*RTFunction).Environment == rTFunction.Environment && other.(*RTFunction).This == rTFunction.This {
 		return NewRTBool(position, true, rTFunction.Environment), nil
 	}
 
@@ -99,7 +209,7 @@ func (rTFunction *RTFunction) Equals(other RTValue, position SEPos) (RTValue, er
 }
 
 func (rTFunction *RTFunction) NotEquals(other RTValue, position SEPos) (RTValue, error) {
-	if other.GetType() == RTT_FUNCTION && other.(*RTFunction).Name == rTFunction.Name && other.(*RTFunction).Environment == rTFunction.Environment {
+	if other.GetType() == RTT_FUNCTION && other.(*RTFunction).Name == rTFunction.Name && other.(*RTFunction).Environment == rTFunction.Environment && other.(*RTFunction).This == rTFunction.This {
 		return NewRTBool(position, false, rTFunction.Environment), nil
 	}
 
@@ -150,26 +260,94 @@ func (rTFunction *RTFunction) Not(position SEPos) (RTValue, error) {
 	return NewRTBool(position, false, rTFunction.Environment), nil
 }
 
+func (rTFunction *RTFunction) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTFunction,
+		nil,
+		position,
+		rTFunction.Environment,
+	)
+}
+
 func (rTFunction *RTFunction) ToBool(position SEPos) (RTValue, error) {
 	return NewRTBool(position, true, rTFunction.Environment), nil
 }
 
-func (rTFunction *RTFunction) Call(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
-	if len(arguments) > len(rTFunction.Parameters) {
-		return nil, NewTooManyArgumentsRTError(rTFunction, len(rTFunction.Parameters), len(arguments), position, interpreter.environment)
-	} else if len(arguments) < len(rTFunction.Parameters) {
-		return nil, NewTooFewArgumentsRTError(rTFunction, len(rTFunction.Parameters), len(arguments), position, interpreter.environment)
+func (rTFunction *RTFunction) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	fixed := len(rTFunction.Parameters)
+	variadic := fixed > 0 && rTFunction.Parameters[fixed-1].Variadic
+	if variadic {
+		fixed--
+	}
+
+	values := make([]RTValue, fixed)
+	rest := make([]RTValue, 0)
+
+	for index, argument := range arguments {
+		if index < fixed {
+			values[index] = argument
+		} else if variadic {
+			rest = append(rest, argument)
+		} else {
+			return nil, NewTooManyArgumentsRTError(rTFunction, fixed, len(arguments)+len(namedArguments), argument, position, interpreter.currentEnv())
+		}
+	}
+
+	for _, named := range namedArguments {
+		index := -1
+		for i, parameter := range rTFunction.Parameters[:fixed] {
+			if parameter.Name.Value == named.Name {
+				index = i
+			}
+		}
+
+		if index == -1 {
+			return nil, NewUnexpectedNamedArgumentRTError(rTFunction, named.Name, named.Pos, interpreter.currentEnv())
+		} else if values[index] != nil {
+			return nil, NewDuplicateArgumentRTError(rTFunction, named.Name, named.Pos, interpreter.currentEnv())
+		}
+
+		values[index] = named.Value
 	}
 
 	runEnv := NewEnvironment(rTFunction.Environment, rTFunction.Name, rTFunction.Pos.Start.Ln, rTFunction.Pos.File.Name, false)
+	runEnv.Caller = interpreter.currentEnv()
 
-	for index, v := range arguments {
-		err := runEnv.Declare(false, rTFunction.Parameters[index].Value, v, position)
+	if rTFunction.This != nil {
+		err := runEnv.Declare(true, "this", rTFunction.This, rTFunction.Pos)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	for index, parameter := range rTFunction.Parameters {
+		var value RTValue
+		if parameter.Variadic {
+			value = NewRTList(position, rest, runEnv)
+		} else if values[index] != nil {
+			value = values[index]
+		} else if parameter.Default != nil {
+			var err error
+
+			value, err = interpreter.evaluate(parameter.Default, runEnv)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, NewTooFewArgumentsRTError(rTFunction, fixed, len(arguments)+len(namedArguments), parameter.Name.Value, position, interpreter.currentEnv())
+		}
+
+		err := runEnv.Declare(false, parameter.Name.Value, value, position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rTFunction.Generator {
+		return NewRTGenerator(rTFunction, runEnv, position, interpreter.currentEnv()), nil
+	}
+
 	_, err := interpreter.VisitBlockStmt(*rTFunction.Block, runEnv, false)
 	if err != nil {
 		return nil, err
@@ -179,5 +357,9 @@ func (rTFunction *RTFunction) Call(arguments []RTValue, position SEPos, interpre
 	interpreter.returnVal = nil
 	interpreter.returnBlock = false
 
+	if val == nil {
+		val = NewRTNull()
+	}
+
 	return val, nil
 }
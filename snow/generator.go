@@ -0,0 +1,462 @@
+package snow
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+var errGeneratorClosed = errors.New("generator closed")
+var errGeneratorAbandoned = errors.New("generator abandoned")
+
+type generatorResult struct {
+	value RTValue
+	done  bool
+	err   error
+}
+
+type generatorState struct {
+	resume chan error
+	yields chan generatorResult
+}
+
+func (state *generatorState) send(result generatorResult) error {
+	select {
+	case state.yields <- result:
+		return nil
+	case err := <-state.resume:
+		return err
+	}
+}
+
+func (state *generatorState) yield(value RTValue) error {
+	err := state.send(generatorResult{value: value})
+	if err != nil {
+		return err
+	}
+
+	return <-state.resume
+}
+
+type generatorHandle struct {
+	state   *generatorState
+	mutex   sync.Mutex
+	started bool
+	running bool
+	done    bool
+}
+
+type RTGenerator struct {
+	*generatorHandle
+	Function    *RTFunction
+	Pos         SEPos
+	Environment *Environment
+	runEnv      *Environment
+}
+
+func NewRTGenerator(function *RTFunction, runEnv *Environment, pos SEPos, env *Environment) *RTGenerator {
+	handle := &generatorHandle{
+		state: &generatorState{
+			resume: make(chan error, 1),
+			yields: make(chan generatorResult),
+		},
+	}
+
+	runtime.SetFinalizer(handle, func(handle *generatorHandle) {
+		handle.mutex.Lock()
+		defer handle.mutex.Unlock()
+
+		if handle.started && !handle.done {
+			select {
+			case handle.state.resume <- errGeneratorAbandoned:
+			default:
+			}
+		}
+	})
+
+	runEnv.Caller = nil
+
+	return &RTGenerator{
+		generatorHandle: handle,
+		Function:        function,
+		Pos:             pos,
+		Environment:     env,
+		runEnv:          runEnv,
+	}
+}
+
+func (rTGenerator *RTGenerator) start(interpreter *Interpreter) {
+	state := rTGenerator.state
+	block := rTGenerator.Function.Block
+	runEnv := rTGenerator.runEnv
+
+	generatorInterpreter := NewInterpreter(nil, interpreter.file, runEnv)
+	generatorInterpreter.modules = interpreter.modules
+	generatorInterpreter.importing = interpreter.importing
+	generatorInterpreter.generator = state
+
+	go func() {
+		_, err := generatorInterpreter.VisitBlockStmt(*block, runEnv, false)
+		if err == errGeneratorAbandoned {
+			return
+		}
+
+		state.send(generatorResult{done: true, err: err})
+	}()
+}
+
+func (rTGenerator *RTGenerator) acquire(tip string, position SEPos, interpreter *Interpreter) (bool, error) {
+	rTGenerator.mutex.Lock()
+	defer rTGenerator.mutex.Unlock()
+
+	if rTGenerator.running {
+		return false, NewRuntimeError(
+			GENERATOR_ERROR,
+			fmt.Sprintf("the generator '%s' is already running", rTGenerator.Function.Name),
+			tip,
+			position,
+			interpreter.currentEnv(),
+		)
+	} else if rTGenerator.done {
+		return false, nil
+	}
+
+	rTGenerator.running = true
+
+	return true, nil
+}
+
+func (rTGenerator *RTGenerator) release() {
+	rTGenerator.mutex.Lock()
+	defer rTGenerator.mutex.Unlock()
+
+	rTGenerator.running = false
+}
+
+func (rTGenerator *RTGenerator) resume(err error, position SEPos, interpreter *Interpreter) generatorResult {
+	rTGenerator.runEnv.Caller = interpreter.currentEnv()
+
+	rTGenerator.mutex.Lock()
+	started := rTGenerator.started
+	rTGenerator.started = true
+	rTGenerator.mutex.Unlock()
+
+	if !started {
+		rTGenerator.start(interpreter)
+	} else {
+		rTGenerator.state.resume <- err
+	}
+
+	result := <-rTGenerator.state.yields
+
+	rTGenerator.runEnv.Caller = nil
+
+	if result.done {
+		rTGenerator.mutex.Lock()
+		rTGenerator.done = true
+		rTGenerator.mutex.Unlock()
+	}
+
+	return result
+}
+
+func (rTGenerator *RTGenerator) Next(position SEPos, interpreter *Interpreter) (RTValue, bool, error) {
+	ok, err := rTGenerator.acquire("A generator can not be resumed by itself or by two tasks at once", position, interpreter)
+	if !ok {
+		return nil, false, err
+	}
+
+	defer rTGenerator.release()
+
+	result := rTGenerator.resume(nil, position, interpreter)
+	if result.done {
+		return nil, false, result.err
+	}
+
+	return result.value, true, nil
+}
+
+func (rTGenerator *RTGenerator) Close(position SEPos, interpreter *Interpreter) error {
+	ok, err := rTGenerator.acquire("A generator can not be closed by itself or while another task resumes it", position, interpreter)
+	if !ok {
+		return err
+	}
+
+	defer rTGenerator.release()
+
+	rTGenerator.mutex.Lock()
+	if !rTGenerator.started {
+		rTGenerator.started = true
+		rTGenerator.done = true
+	}
+	rTGenerator.mutex.Unlock()
+
+	for !rTGenerator.finished() {
+		result := rTGenerator.resume(errGeneratorClosed, position, interpreter)
+		if result.done && result.err != errGeneratorClosed {
+			return result.err
+		}
+	}
+
+	return nil
+}
+
+func (rTGenerator *RTGenerator) finished() bool {
+	rTGenerator.mutex.Lock()
+	defer rTGenerator.mutex.Unlock()
+
+	return rTGenerator.done
+}
+
+func (rTGenerator *RTGenerator) ToString() string {
+	return fmt.Sprintf("(GENERATOR: %s)", rTGenerator.Function.Name)
+}
+
+func (rTGenerator *RTGenerator) ValueToString() string {
+	return fmt.Sprintf("GENERATOR %s", rTGenerator.Function.Name)
+}
+
+func (rTGenerator *RTGenerator) GetType() RTType {
+	return RTT_GENERATOR
+}
+
+func (rTGenerator *RTGenerator) GetValue() interface{} {
+	return rTGenerator.Function
+}
+
+func (rTGenerator *RTGenerator) GetEnvironment() *Environment {
+	return rTGenerator.Environment
+}
+
+func (rTGenerator *RTGenerator) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "close":
+		return NewRTBuiltinFunction("close", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			err := rTGenerator.Close(position, interpreter)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewRTNull(), nil
+		}, position, rTGenerator.Environment), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTGenerator, other, position, rTGenerator.Environment)
+}
+
+func (rTGenerator *RTGenerator) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTGenerator, other, value, position, rTGenerator.Environment)
+}
+
+func (rTGenerator *RTGenerator) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTGenerator, position, rTGenerator.Environment)
+}
+
+func (rTGenerator *RTGenerator) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTGenerator, position, rTGenerator.Environment)
+}
+
+func (rTGenerator *RTGenerator) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTGenerator, position, rTGenerator.Environment)
+}
+
+func (rTGenerator *RTGenerator) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTGenerator, position, rTGenerator.Environment)
+}
+
+func (rTGenerator *RTGenerator) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return func() (RTValue, bool, error) {
+		return rTGenerator.Next(position, interpreter)
+	}, nil
+}
+
+func (rTGenerator *RTGenerator) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTGenerator), rTGenerator.Environment), nil
+}
+
+func (rTGenerator *RTGenerator) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTGenerator), rTGenerator.Environment), nil
+}
+
+func (rTGenerator *RTGenerator) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTGenerator,
+		other,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTGenerator.Environment), nil
+}
+
+func (rTGenerator *RTGenerator) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTGenerator,
+		nil,
+		position,
+		rTGenerator.Environment,
+	)
+}
+
+func (rTGenerator *RTGenerator) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTGenerator.Environment), nil
+}
+
+func (rTGenerator *RTGenerator) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTGenerator, position, rTGenerator.Environment)
+}
@@ -0,0 +1,317 @@
+package snow
+
+import (
+	"fmt"
+	"sync"
+)
+
+type RTInstance struct {
+	Class       *RTClass
+	Fields      map[string]RTValue
+	Pos         SEPos
+	Environment *Environment
+	mutex       sync.RWMutex
+}
+
+func NewRTInstance(class *RTClass, pos SEPos, env *Environment) *RTInstance {
+	return &RTInstance{
+		Class:       class,
+		Fields:      make(map[string]RTValue, 0),
+		Pos:         pos,
+		Environment: env,
+	}
+}
+
+func (rTInstance *RTInstance) Field(name string) (RTValue, bool) {
+	rTInstance.mutex.RLock()
+	defer rTInstance.mutex.RUnlock()
+
+	value, ok := rTInstance.Fields[name]
+
+	return value, ok
+}
+
+func (rTInstance *RTInstance) ToString() string {
+	return fmt.Sprintf("(INSTANCE: %s)", rTInstance.Class.Name)
+}
+
+func (rTInstance *RTInstance) ValueToString() string {
+	return fmt.Sprintf("INSTANCE OF %s", rTInstance.Class.Name)
+}
+
+func (rTInstance *RTInstance) GetType() RTType {
+	return RTT_INSTANCE
+}
+
+func (rTInstance *RTInstance) GetValue() interface{} {
+	rTInstance.mutex.RLock()
+	defer rTInstance.mutex.RUnlock()
+
+	fields := make(map[string]RTValue, len(rTInstance.Fields))
+	for name, value := range rTInstance.Fields {
+		fields[name] = value
+	}
+
+	return fields
+}
+
+func (rTInstance *RTInstance) GetEnvironment() *Environment {
+	return rTInstance.Environment
+}
+
+func (rTInstance *RTInstance) Dot(other Token, position SEPos) (RTValue, error) {
+	if value, ok := rTInstance.Field(other.Value); ok {
+		return value, nil
+	}
+
+	method := rTInstance.Class.FindMethod(other.Value)
+	if method != nil {
+		return method.Bind(rTInstance), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTInstance, other, position, rTInstance.Environment)
+}
+
+func (rTInstance *RTInstance) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	rTInstance.mutex.Lock()
+	rTInstance.Fields[other] = value
+	rTInstance.mutex.Unlock()
+
+	return value, nil
+}
+
+func (rTInstance *RTInstance) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTInstance, position, rTInstance.Environment)
+}
+
+func (rTInstance *RTInstance) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTInstance, position, rTInstance.Environment)
+}
+
+func (rTInstance *RTInstance) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTInstance, position, rTInstance.Environment)
+}
+
+func (rTInstance *RTInstance) Hash(position SEPos) (string, error) {
+	return fmt.Sprintf("i:%p", rTInstance), nil
+}
+
+func (rTInstance *RTInstance) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	iterMethod := rTInstance.Class.FindMethod("__iter__")
+	if iterMethod == nil {
+		return nil, NewNotIterableRTError(rTInstance, position, rTInstance.Environment)
+	}
+
+	iterator, err := iterMethod.Bind(rTInstance).Call([]RTValue{}, nil, position, interpreter)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := iterator.Dot(*NewToken(IDENTIFIER, "__next__", position), position)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() (RTValue, bool, error) {
+		value, err := next.Call([]RTValue{}, nil, position, interpreter)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if value.GetType() == RTT_NULL {
+			return nil, false, nil
+		}
+
+		return value, true, nil
+	}, nil
+}
+
+func (rTInstance *RTInstance) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTInstance), rTInstance.Environment), nil
+}
+
+func (rTInstance *RTInstance) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTInstance), rTInstance.Environment), nil
+}
+
+func (rTInstance *RTInstance) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTInstance,
+		other,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTInstance.Environment), nil
+}
+
+func (rTInstance *RTInstance) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTInstance,
+		nil,
+		position,
+		rTInstance.Environment,
+	)
+}
+
+func (rTInstance *RTInstance) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTInstance.Environment), nil
+}
+
+func (rTInstance *RTInstance) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTInstance, position, rTInstance.Environment)
+}
@@ -2,11 +2,16 @@ package snow
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 )
 
+const MaxIntBits = 1 << 20
+
 type RTInt struct {
 	Pos         SEPos
 	Value       int
+	Big         *big.Int
 	Environment *Environment
 }
 
@@ -18,11 +23,27 @@ func NewRTInt(pos SEPos, value int, env *Environment) *RTInt {
 	}
 }
 
+func NewRTBigInt(pos SEPos, value *big.Int, env *Environment) *RTInt {
+	if value.IsInt64() && value.Int64() >= math.MinInt && value.Int64() <= math.MaxInt {
+		return NewRTInt(pos, int(value.Int64()), env)
+	}
+
+	return &RTInt{
+		Pos:         pos,
+		Big:         value,
+		Environment: env,
+	}
+}
+
 func (rTInt *RTInt) ToString() string {
-	return fmt.Sprintf("(INT: %d)", rTInt.Value)
+	return fmt.Sprintf("(INT: %s)", rTInt.ValueToString())
 }
 
 func (rTInt *RTInt) ValueToString() string {
+	if rTInt.Big != nil {
+		return rTInt.Big.String()
+	}
+
 	return fmt.Sprintf("%d", rTInt.Value)
 }
 
@@ -31,6 +52,10 @@ func (rTInt *RTInt) GetType() RTType {
 }
 
 func (rTInt *RTInt) GetValue() interface{} {
+	if rTInt.Big != nil {
+		return rTInt.Big
+	}
+
 	return rTInt.Value
 }
 
@@ -46,13 +71,39 @@ func (rTInt *RTInt) SetAttribute(other string, value RTValue, position SEPos) (R
 	return nil, NewUnableToAssignAttributeRTError(rTInt, other, value, position, rTInt.Environment)
 }
 
+func (rTInt *RTInt) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTInt, position, rTInt.Environment)
+}
+
+func (rTInt *RTInt) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTInt, position, rTInt.Environment)
+}
+
+func (rTInt *RTInt) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTInt, position, rTInt.Environment)
+}
+
+func (rTInt *RTInt) Hash(position SEPos) (string, error) {
+	return "n:" + rTInt.ValueToString(), nil
+}
+
+func (rTInt *RTInt) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTInt, position, rTInt.Environment)
+}
+
 func (rTInt *RTInt) Add(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
+		o := other.(*RTInt)
+		if rTInt.Big == nil && o.Big == nil {
+			if value, ok := addInt(rTInt.Value, o.Value); ok {
+				return NewRTInt(position, value, rTInt.Environment), nil
+			}
+		}
 
-		return NewRTInt(position, rTInt.Value+other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBigInt(position, new(big.Int).Add(rTInt.big(), o.big()), rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTFloat(position, float64(rTInt.Value)+other.GetValue().(float64), rTInt.Environment), nil
+		return NewRTFloat(position, rTInt.Float()+other.GetValue().(float64), rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -67,9 +118,16 @@ func (rTInt *RTInt) Add(other RTValue, position SEPos) (RTValue, error) {
 func (rTInt *RTInt) Subtract(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTInt(position, rTInt.Value-other.GetValue().(int), rTInt.Environment), nil
+		o := other.(*RTInt)
+		if rTInt.Big == nil && o.Big == nil {
+			if value, ok := subtractInt(rTInt.Value, o.Value); ok {
+				return NewRTInt(position, value, rTInt.Environment), nil
+			}
+		}
+
+		return NewRTBigInt(position, new(big.Int).Sub(rTInt.big(), o.big()), rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTFloat(position, float64(rTInt.Value)-other.GetValue().(float64), rTInt.Environment), nil
+		return NewRTFloat(position, rTInt.Float()-other.GetValue().(float64), rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -84,9 +142,18 @@ func (rTInt *RTInt) Subtract(other RTValue, position SEPos) (RTValue, error) {
 func (rTInt *RTInt) Multiply(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTInt(position, rTInt.Value*other.GetValue().(int), rTInt.Environment), nil
+		o := other.(*RTInt)
+		if rTInt.Big == nil && o.Big == nil {
+			if value, ok := multiplyInt(rTInt.Value, o.Value); ok {
+				return NewRTInt(position, value, rTInt.Environment), nil
+			}
+		}
+
+		return NewRTBigInt(position, new(big.Int).Mul(rTInt.big(), o.big()), rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTFloat(position, float64(rTInt.Value)*other.GetValue().(float64), rTInt.Environment), nil
+		return NewRTFloat(position, rTInt.Float()*other.GetValue().(float64), rTInt.Environment), nil
+	case RTT_STRING:
+		return other.(*RTString).repeat(rTInt, position)
 	}
 
 	return nil, NewValueRTError(
@@ -101,17 +168,17 @@ func (rTInt *RTInt) Multiply(other RTValue, position SEPos) (RTValue, error) {
 func (rTInt *RTInt) Divide(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		if other.GetValue().(int) == 0 {
+		if other.(*RTInt).isZero() {
 			return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
 		}
 
-		return NewRTFloat(position, float64(rTInt.Value)/float64(other.GetValue().(int)), rTInt.Environment), nil
+		return NewRTFloat(position, rTInt.Float()/other.(*RTInt).Float(), rTInt.Environment), nil
 	case RTT_FLOAT:
 		if other.GetValue().(float64) == 0 {
 			return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
 		}
 
-		return NewRTFloat(position, float64(rTInt.Value)/other.GetValue().(float64), rTInt.Environment), nil
+		return NewRTFloat(position, rTInt.Float()/other.GetValue().(float64), rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -123,12 +190,244 @@ func (rTInt *RTInt) Divide(other RTValue, position SEPos) (RTValue, error) {
 	)
 }
 
+func (rTInt *RTInt) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if o.isZero() {
+			return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
+		}
+
+		if rTInt.Big == nil && o.Big == nil {
+			return NewRTInt(position, floorMod(rTInt.Value, o.Value), rTInt.Environment), nil
+		}
+
+		_, remainder := bigFloorDivMod(rTInt.big(), o.big())
+
+		return NewRTBigInt(position, remainder, rTInt.Environment), nil
+	case RTT_FLOAT:
+		if other.GetValue().(float64) == 0 {
+			return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
+		}
+
+		return NewRTFloat(position, floatMod(rTInt.Float(), other.GetValue().(float64)), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PERCENT,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) Power(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if o.sign() < 0 {
+			if rTInt.isZero() {
+				return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
+			}
+
+			return NewRTFloat(position, math.Pow(rTInt.Float(), o.Float()), rTInt.Environment), nil
+		}
+
+		if rTInt.Big == nil && o.Big == nil {
+			if value, ok := intPow(rTInt.Value, o.Value); ok {
+				return NewRTInt(position, value, rTInt.Environment), nil
+			}
+		}
+
+		exponent, err := toInt(o, position, rTInt.Environment)
+		if err != nil {
+			return nil, err
+		}
+
+		if bits := rTInt.big().BitLen() - 1; bits > 0 && exponent > MaxIntBits/bits {
+			return nil, NewTooBigValueRTError(other, position, rTInt.Environment)
+		}
+
+		return NewRTBigInt(position, new(big.Int).Exp(rTInt.big(), big.NewInt(int64(exponent)), nil), rTInt.Environment), nil
+	case RTT_FLOAT:
+		return NewRTFloat(position, math.Pow(rTInt.Float(), other.GetValue().(float64)), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if o.isZero() {
+			return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
+		}
+
+		if rTInt.Big == nil && o.Big == nil && !(rTInt.Value == math.MinInt && o.Value == -1) {
+			return NewRTInt(position, floorDiv(rTInt.Value, o.Value), rTInt.Environment), nil
+		}
+
+		quotient, _ := bigFloorDivMod(rTInt.big(), o.big())
+
+		return NewRTBigInt(position, quotient, rTInt.Environment), nil
+	case RTT_FLOAT:
+		if other.GetValue().(float64) == 0 {
+			return nil, NewDivisionByZeroRTError(rTInt, other, position, rTInt.Environment)
+		}
+
+		return NewRTFloat(position, math.Floor(rTInt.Float()/other.GetValue().(float64)), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if rTInt.Big == nil && o.Big == nil {
+			return NewRTInt(position, rTInt.Value&o.Value, rTInt.Environment), nil
+		}
+
+		return NewRTBigInt(position, new(big.Int).And(rTInt.big(), o.big()), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if rTInt.Big == nil && o.Big == nil {
+			return NewRTInt(position, rTInt.Value|o.Value, rTInt.Environment), nil
+		}
+
+		return NewRTBigInt(position, new(big.Int).Or(rTInt.big(), o.big()), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PIPE,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if rTInt.Big == nil && o.Big == nil {
+			return NewRTInt(position, rTInt.Value^o.Value, rTInt.Environment), nil
+		}
+
+		return NewRTBigInt(position, new(big.Int).Xor(rTInt.big(), o.big()), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		CARET,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if o.sign() < 0 {
+			return nil, NewNegativeShiftCountRTError(rTInt, other, position, rTInt.Environment)
+		}
+
+		count, err := toInt(o, position, rTInt.Environment)
+		if err != nil {
+			return nil, err
+		}
+
+		if rTInt.Big == nil && count < 63 && (rTInt.Value<<count)>>count == rTInt.Value {
+			return NewRTInt(position, rTInt.Value<<count, rTInt.Environment), nil
+		}
+
+		if rTInt.isZero() {
+			return NewRTInt(position, 0, rTInt.Environment), nil
+		}
+
+		if count > MaxIntBits-rTInt.big().BitLen() {
+			return nil, NewTooBigValueRTError(other, position, rTInt.Environment)
+		}
+
+		return NewRTBigInt(position, new(big.Int).Lsh(rTInt.big(), uint(count)), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
+func (rTInt *RTInt) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		o := other.(*RTInt)
+		if o.sign() < 0 {
+			return nil, NewNegativeShiftCountRTError(rTInt, other, position, rTInt.Environment)
+		}
+
+		if o.Big != nil {
+			return NewRTInt(position, rTInt.sign()>>1, rTInt.Environment), nil
+		}
+
+		if rTInt.Big == nil {
+			return NewRTInt(position, rTInt.Value>>o.Value, rTInt.Environment), nil
+		}
+
+		return NewRTBigInt(position, new(big.Int).Rsh(rTInt.big(), uint(o.Value)), rTInt.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTInt,
+		other,
+		position,
+		rTInt.Environment,
+	)
+}
+
 func (rTInt *RTInt) Equals(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTBool(position, rTInt.Value == other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBool(position, rTInt.compare(other.(*RTInt)) == 0, rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTBool(position, float64(rTInt.Value) == other.GetValue().(float64), rTInt.Environment), nil
+		c, ok := rTInt.compareFloat(other.GetValue().(float64))
+
+		return NewRTBool(position, ok && c == 0, rTInt.Environment), nil
 	}
 
 	return NewRTBool(position, false, rTInt.Environment), nil
@@ -137,9 +436,11 @@ func (rTInt *RTInt) Equals(other RTValue, position SEPos) (RTValue, error) {
 func (rTInt *RTInt) NotEquals(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTBool(position, rTInt.Value != other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBool(position, rTInt.compare(other.(*RTInt)) != 0, rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTBool(position, float64(rTInt.Value) != other.GetValue().(float64), rTInt.Environment), nil
+		c, ok := rTInt.compareFloat(other.GetValue().(float64))
+
+		return NewRTBool(position, !ok || c != 0, rTInt.Environment), nil
 	}
 
 	return NewRTBool(position, true, rTInt.Environment), nil
@@ -148,9 +449,11 @@ func (rTInt *RTInt) NotEquals(other RTValue, position SEPos) (RTValue, error) {
 func (rTInt *RTInt) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTBool(position, rTInt.Value > other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBool(position, rTInt.compare(other.(*RTInt)) > 0, rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTBool(position, float64(rTInt.Value) > other.GetValue().(float64), rTInt.Environment), nil
+		c, ok := rTInt.compareFloat(other.GetValue().(float64))
+
+		return NewRTBool(position, ok && c > 0, rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -165,9 +468,11 @@ func (rTInt *RTInt) GreaterThan(other RTValue, position SEPos) (RTValue, error)
 func (rTInt *RTInt) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTBool(position, rTInt.Value >= other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBool(position, rTInt.compare(other.(*RTInt)) >= 0, rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTBool(position, float64(rTInt.Value) >= other.GetValue().(float64), rTInt.Environment), nil
+		c, ok := rTInt.compareFloat(other.GetValue().(float64))
+
+		return NewRTBool(position, ok && c >= 0, rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -182,9 +487,11 @@ func (rTInt *RTInt) GreaterThanEquals(other RTValue, position SEPos) (RTValue, e
 func (rTInt *RTInt) LessThan(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTBool(position, rTInt.Value < other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBool(position, rTInt.compare(other.(*RTInt)) < 0, rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTBool(position, float64(rTInt.Value) < other.GetValue().(float64), rTInt.Environment), nil
+		c, ok := rTInt.compareFloat(other.GetValue().(float64))
+
+		return NewRTBool(position, ok && c < 0, rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -199,9 +506,11 @@ func (rTInt *RTInt) LessThan(other RTValue, position SEPos) (RTValue, error) {
 func (rTInt *RTInt) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
 	switch other.GetType() {
 	case RTT_INT:
-		return NewRTBool(position, rTInt.Value <= other.GetValue().(int), rTInt.Environment), nil
+		return NewRTBool(position, rTInt.compare(other.(*RTInt)) <= 0, rTInt.Environment), nil
 	case RTT_FLOAT:
-		return NewRTBool(position, float64(rTInt.Value) <= other.GetValue().(float64), rTInt.Environment), nil
+		c, ok := rTInt.compareFloat(other.GetValue().(float64))
+
+		return NewRTBool(position, ok && c <= 0, rTInt.Environment), nil
 	}
 
 	return nil, NewValueRTError(
@@ -214,21 +523,178 @@ func (rTInt *RTInt) LessThanEquals(other RTValue, position SEPos) (RTValue, erro
 }
 
 func (rTInt *RTInt) Not(position SEPos) (RTValue, error) {
-	if rTInt.Value == 0 {
+	if rTInt.isZero() {
 		return NewRTBool(position, true, rTInt.Environment), nil
 	}
 
 	return NewRTBool(position, false, rTInt.Environment), nil
 }
 
+func (rTInt *RTInt) BitwiseNot(position SEPos) (RTValue, error) {
+	if rTInt.Big != nil {
+		return NewRTBigInt(position, new(big.Int).Not(rTInt.Big), rTInt.Environment), nil
+	}
+
+	return NewRTInt(position, ^rTInt.Value, rTInt.Environment), nil
+}
+
 func (rTInt *RTInt) ToBool(position SEPos) (RTValue, error) {
-	if rTInt.Value == 0 {
+	if rTInt.isZero() {
 		return NewRTBool(position, false, rTInt.Environment), nil
 	}
 
 	return NewRTBool(position, true, rTInt.Environment), nil
 }
 
-func (rTInt *RTInt) Call(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+func (rTInt *RTInt) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
 	return nil, NewInvalidCallRTError(rTInt, position, rTInt.Environment)
 }
+
+func (rTInt *RTInt) Float() float64 {
+	if rTInt.Big != nil {
+		value, _ := new(big.Float).SetInt(rTInt.Big).Float64()
+
+		return value
+	}
+
+	return float64(rTInt.Value)
+}
+
+func (rTInt *RTInt) compareFloat(value float64) (int, bool) {
+	if math.IsNaN(value) {
+		return 0, false
+	}
+
+	if math.IsInf(value, 1) {
+		return -1, true
+	} else if math.IsInf(value, -1) {
+		return 1, true
+	}
+
+	return new(big.Float).SetInt(rTInt.big()).Cmp(big.NewFloat(value)), true
+}
+
+func (rTInt *RTInt) big() *big.Int {
+	if rTInt.Big != nil {
+		return rTInt.Big
+	}
+
+	return big.NewInt(int64(rTInt.Value))
+}
+
+func (rTInt *RTInt) sign() int {
+	if rTInt.Big != nil {
+		return rTInt.Big.Sign()
+	}
+
+	if rTInt.Value < 0 {
+		return -1
+	} else if rTInt.Value > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func (rTInt *RTInt) isZero() bool {
+	return rTInt.Big == nil && rTInt.Value == 0
+}
+
+func (rTInt *RTInt) compare(other *RTInt) int {
+	if rTInt.Big == nil && other.Big == nil {
+		if rTInt.Value < other.Value {
+			return -1
+		} else if rTInt.Value > other.Value {
+			return 1
+		}
+
+		return 0
+	}
+
+	return rTInt.big().Cmp(other.big())
+}
+
+func toInt(value RTValue, position SEPos, env *Environment) (int, error) {
+	rTInt := value.(*RTInt)
+	if rTInt.Big != nil {
+		return 0, NewTooBigValueRTError(rTInt, position, env)
+	}
+
+	return rTInt.Value, nil
+}
+
+func addInt(x int, y int) (int, bool) {
+	sum := x + y
+
+	return sum, (sum > x) == (y > 0)
+}
+
+func subtractInt(x int, y int) (int, bool) {
+	difference := x - y
+
+	return difference, (difference < x) == (y > 0)
+}
+
+func multiplyInt(x int, y int) (int, bool) {
+	if x == 0 || y == 0 {
+		return 0, true
+	}
+
+	product := x * y
+	if product/y != x || (x == -1 && y == math.MinInt) || (y == -1 && x == math.MinInt) {
+		return 0, false
+	}
+
+	return product, true
+}
+
+func floorDiv(x int, y int) int {
+	quotient := x / y
+	if x%y != 0 && (x < 0) != (y < 0) {
+		quotient--
+	}
+
+	return quotient
+}
+
+func floorMod(x int, y int) int {
+	remainder := x % y
+	if remainder != 0 && (remainder < 0) != (y < 0) {
+		remainder += y
+	}
+
+	return remainder
+}
+
+func bigFloorDivMod(x *big.Int, y *big.Int) (*big.Int, *big.Int) {
+	quotient, remainder := new(big.Int).QuoRem(x, y, new(big.Int))
+	if remainder.Sign() != 0 && remainder.Sign() != y.Sign() {
+		quotient.Sub(quotient, big.NewInt(1))
+		remainder.Add(remainder, y)
+	}
+
+	return quotient, remainder
+}
+
+func intPow(base int, exponent int) (int, bool) {
+	result := 1
+	for exponent > 0 {
+		var ok bool
+		if exponent&1 == 1 {
+			result, ok = multiplyInt(result, base)
+			if !ok {
+				return 0, false
+			}
+		}
+
+		exponent >>= 1
+		if exponent > 0 {
+			base, ok = multiplyInt(base, base)
+			if !ok {
+				return 0, false
+			}
+		}
+	}
+
+	return result, true
+}
@@ -15,8 +15,13 @@ type Interpreter struct {
 	inFunc       int
 	continueLoop bool
 	breakLoop    bool
+	loopLabel    string
 	returnBlock  bool
 	returnVal    RTValue
+	callerEnv    *Environment
+	modules      *ModuleLoader
+	importing    []string
+	generator    *generatorState
 }
 
 func NewInterpreter(statements []Stmt, file *File, env *Environment) *Interpreter {
@@ -25,9 +30,18 @@ func NewInterpreter(statements []Stmt, file *File, env *Environment) *Interprete
 		file:        file,
 		index:       -1,
 		environment: env,
+		modules:     defaultModuleLoader,
 	}
 }
 
+func (interpreter *Interpreter) currentEnv() *Environment {
+	if interpreter.callerEnv != nil {
+		return interpreter.callerEnv
+	}
+
+	return interpreter.environment
+}
+
 func (interpreter *Interpreter) advance() {
 	if !interpreter.end {
 		interpreter.index++
@@ -58,11 +72,24 @@ func (interpreter *Interpreter) Interpret() ([]RTValue, error) {
 }
 
 func (interpreter *Interpreter) execute(statement Stmt, env *Environment) (RTValue, error) {
-	return statement.Accept(interpreter, env)
+	value, err := statement.Accept(interpreter, env)
+
+	return value, locateError(err, env)
 }
 
 func (interpreter *Interpreter) evaluate(expression Expr, env *Environment) (RTValue, error) {
-	return expression.Accept(interpreter, env)
+	value, err := expression.Accept(interpreter, env)
+
+	return value, locateError(err, env)
+}
+
+func locateError(err error, env *Environment) error {
+	if rTError, ok := err.(*RTError); ok && !rTError.located {
+		rTError.environment = env
+		rTError.located = true
+	}
+
+	return err
 }
 
 func (interpreter *Interpreter) VisitExpressionStmt(stmt ExpressionStmt, env *Environment) (RTValue, error) {
@@ -80,6 +107,15 @@ func (interpreter *Interpreter) VisitVarDeclStmt(stmt VarDeclStmt, env *Environm
 		return nil, err
 	}
 
+	if stmt.Pattern != nil {
+		err = NewDestructurer(interpreter, stmt.VarType.TType == CONST, false).Destructure(stmt.Pattern, value, env)
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	}
+
 	err = env.Declare(
 		stmt.VarType.TType == CONST,
 		stmt.Identifier.Value,
@@ -123,7 +159,7 @@ func (interpreter *Interpreter) VisitIfStmtContainer(stmt IfStmtContainer, env *
 			return nil, err
 		}
 
-		if val != nil {
+		if val.GetType() != RTT_NULL {
 			break
 		}
 	}
@@ -139,8 +175,12 @@ func (interpreter *Interpreter) VisitIfStmt(stmt IfStmt, env *Environment) (RTVa
 		}
 
 		exprAsBool, err := exprEvaluated.ToBool(stmt.Expression.GetPosition())
+		if err != nil {
+			return nil, err
+		}
+
 		if exprAsBool.GetValue() == false {
-			return nil, nil
+			return NewRTNull(), nil
 		}
 	}
 
@@ -171,11 +211,7 @@ func (interpreter *Interpreter) VisitWhileStmt(stmt WhileStmt, env *Environment)
 			return nil, err
 		}
 
-		if interpreter.continueLoop {
-			interpreter.continueLoop = false
-			continue
-		} else if interpreter.breakLoop {
-			interpreter.breakLoop = false
+		if interpreter.exitLoop(stmt.Label) {
 			break
 		}
 
@@ -195,8 +231,93 @@ func (interpreter *Interpreter) VisitWhileStmt(stmt WhileStmt, env *Environment)
 	return nil, nil
 }
 
+func (interpreter *Interpreter) VisitForStmt(stmt ForStmt, env *Environment) (RTValue, error) {
+	iterable, err := interpreter.evaluate(stmt.Iterable, env)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := iterable.Iter(stmt.Iterable.GetPosition(), interpreter)
+	if err != nil {
+		return nil, err
+	}
+
+	interpreter.inLoop += 1
+
+	for {
+		value, ok, err := next()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			break
+		}
+
+		loopEnv := NewEnvironment(env, "", stmt.Pos.Start.Ln, stmt.Pos.File.Name, false)
+
+		err = loopEnv.Declare(false, stmt.Identifier.Value, value, stmt.Identifier.Pos)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = interpreter.execute(stmt.Statement, loopEnv)
+		if err != nil {
+			return nil, interpreter.closeIterable(iterable, err, stmt.Pos)
+		}
+
+		if interpreter.exitLoop(stmt.Label) {
+			err = interpreter.closeIterable(iterable, nil, stmt.Pos)
+			if err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	interpreter.inLoop -= 1
+
+	return nil, nil
+}
+
+func (interpreter *Interpreter) closeIterable(iterable RTValue, err error, position SEPos) error {
+	generator, ok := iterable.(*RTGenerator)
+	if !ok {
+		return err
+	}
+
+	closeErr := generator.Close(position, interpreter)
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return err
+}
+
+func (interpreter *Interpreter) exitLoop(label *Token) bool {
+	if interpreter.returnBlock {
+		return true
+	} else if interpreter.loopLabel != "" && (label == nil || label.Value != interpreter.loopLabel) {
+		return interpreter.breakLoop || interpreter.continueLoop
+	}
+
+	interpreter.loopLabel = ""
+
+	if interpreter.breakLoop {
+		interpreter.breakLoop = false
+
+		return true
+	}
+
+	interpreter.continueLoop = false
+
+	return false
+}
+
 func (interpreter *Interpreter) VisitFunctionDeclStmt(stmt FunctionDeclStmt, env *Environment) (RTValue, error) {
 	rTFunc := NewRTFunction(stmt.Name, stmt.Parameters, stmt.Block, stmt.Pos, env)
+	rTFunc.Generator = stmt.Generator
 
 	err := env.Declare(true, stmt.Name, rTFunc, rTFunc.Pos)
 	if err != nil {
@@ -206,28 +327,87 @@ func (interpreter *Interpreter) VisitFunctionDeclStmt(stmt FunctionDeclStmt, env
 	return nil, nil
 }
 
+func (interpreter *Interpreter) VisitClassDeclStmt(stmt ClassDeclStmt, env *Environment) (RTValue, error) {
+	var superClass *RTClass
+	methodEnv := env
+
+	if stmt.SuperClass != nil {
+		value, err := interpreter.evaluate(stmt.SuperClass, env)
+		if err != nil {
+			return nil, err
+		}
+
+		class, ok := value.(*RTClass)
+		if !ok {
+			return nil, NewRuntimeError(
+				INVALID_SUPERCLASS_ERROR,
+				fmt.Sprintf("unable to inherit from object of type '%s'", value.GetType()),
+				"Classes can only inherit from other classes",
+				stmt.SuperClass.Pos,
+				env,
+			)
+		}
+
+		superClass = class
+
+		methodEnv = NewEnvironment(env, stmt.Name, stmt.Pos.Start.Ln, stmt.Pos.File.Name, false)
+
+		err = methodEnv.Declare(true, "super", superClass, stmt.SuperClass.Pos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	methods := make(map[string]*RTFunction, 0)
+	for _, method := range stmt.Methods {
+		methods[method.Name] = NewRTFunction(method.Name, method.Parameters, method.Block, method.Pos, methodEnv)
+		methods[method.Name].Generator = method.Generator
+	}
+
+	rTClass := NewRTClass(stmt.Name, superClass, methods, stmt.Pos, env)
+
+	err := env.Declare(true, stmt.Name, rTClass, stmt.Pos)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 func (interpreter *Interpreter) VisitBreakStmt(stmt BreakStmt, env *Environment) (RTValue, error) {
 	interpreter.breakLoop = true
+	if stmt.Label != nil {
+		interpreter.loopLabel = stmt.Label.Value
+	}
+
 	return nil, nil
 }
 
 func (interpreter *Interpreter) VisitContinueStmt(stmt ContinueStmt, env *Environment) (RTValue, error) {
 	interpreter.continueLoop = true
+	if stmt.Label != nil {
+		interpreter.loopLabel = stmt.Label.Value
+	}
+
 	return nil, nil
 }
 
 func (interpreter *Interpreter) VisitReturnStmt(stmt ReturnStmt, env *Environment) (RTValue, error) {
-	interpreter.returnBlock = true
+	if stmt.Value == nil {
+		interpreter.returnVal = NewRTNull()
+		interpreter.returnBlock = true
 
-	if stmt.Value != nil {
-		val, err := interpreter.evaluate(stmt.Value, env)
-		if err != nil {
-			return nil, err
-		}
+		return nil, nil
+	}
 
-		interpreter.returnVal = val
+	val, err := interpreter.evaluate(stmt.Value, env)
+	if err != nil {
+		return nil, err
 	}
 
+	interpreter.returnVal = val
+	interpreter.returnBlock = true
+
 	return nil, nil
 }
 
@@ -242,37 +422,82 @@ func (interpreter *Interpreter) VisitBinaryExpr(expr BinaryExpr, env *Environmen
 		return nil, err
 	}
 
-	switch expr.Tok.TType {
+	return interpreter.binaryOperation(left, right, expr.Tok, expr.Pos)
+}
+
+func (interpreter *Interpreter) binaryOperation(left RTValue, right RTValue, tok Token, pos SEPos) (RTValue, error) {
+	switch tok.TType {
 	case PLUS:
-		return left.Add(right, expr.Pos)
+		return left.Add(right, pos)
 	case DASH:
-		return left.Subtract(right, expr.Pos)
+		return left.Subtract(right, pos)
 	case STAR:
-		return left.Multiply(right, expr.Pos)
+		return left.Multiply(right, pos)
 	case SLASH:
-		return left.Divide(right, expr.Pos)
+		return left.Divide(right, pos)
+	case PERCENT:
+		return left.Modulo(right, pos)
+	case DOUBLE_STAR:
+		return left.Power(right, pos)
+	case DOUBLE_SLASH:
+		return left.FloorDivide(right, pos)
+	case AMPERSAND:
+		return left.BitwiseAnd(right, pos)
+	case PIPE:
+		return left.BitwiseOr(right, pos)
+	case CARET:
+		return left.BitwiseXor(right, pos)
+	case LEFT_SHIFT:
+		return left.LeftShift(right, pos)
+	case RIGHT_SHIFT:
+		return left.RightShift(right, pos)
 	case EQUALS:
-		return left.Equals(right, expr.Pos)
+		return left.Equals(right, pos)
 	case NOT_EQUALS:
-		return left.NotEquals(right, expr.Pos)
+		return left.NotEquals(right, pos)
 	case GREATER_THAN:
-		return left.GreaterThan(right, expr.Pos)
+		return left.GreaterThan(right, pos)
 	case GREATER_THAN_EQUALS:
-		return left.GreaterThanEquals(right, expr.Pos)
+		return left.GreaterThanEquals(right, pos)
 	case LESS_THAN:
-		return left.LessThan(right, expr.Pos)
+		return left.LessThan(right, pos)
 	case LESS_THAN_EQUALS:
-		return left.LessThanEquals(right, expr.Pos)
+		return left.LessThanEquals(right, pos)
 	default:
 		return nil, NewSnowError(
 			INVALID_OP_TOKEN_ERROR,
-			fmt.Sprintf("the op token '%s' is not valid", expr.Tok.TType),
+			fmt.Sprintf("the op token '%s' is not valid", tok.TType),
 			"",
-			expr.Pos,
+			pos,
 		)
 	}
 }
 
+func (interpreter *Interpreter) VisitLogicalExpr(expr LogicalExpr, env *Environment) (RTValue, error) {
+	left, err := interpreter.evaluate(expr.Left, env)
+	if err != nil {
+		return nil, err
+	}
+
+	leftBool, err := left.ToBool(expr.Left.GetPosition())
+	if err != nil {
+		return nil, err
+	}
+
+	if expr.Tok.TType == OR && leftBool.GetValue() == true {
+		return left, nil
+	} else if expr.Tok.TType == AND && leftBool.GetValue() == false {
+		return left, nil
+	}
+
+	right, err := interpreter.evaluate(expr.Right, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return right, nil
+}
+
 func (interpreter *Interpreter) VisitUnaryExpr(expr UnaryExpr, env *Environment) (RTValue, error) {
 	right, err := interpreter.evaluate(expr.Right, env)
 	if err != nil {
@@ -292,6 +517,13 @@ func (interpreter *Interpreter) VisitUnaryExpr(expr UnaryExpr, env *Environment)
 			return nil, err
 		}
 
+		return val, nil
+	} else if expr.Tok.TType == TILDE {
+		val, err := right.BitwiseNot(expr.Pos)
+		if err != nil {
+			return nil, err
+		}
+
 		return val, nil
 	}
 
@@ -314,6 +546,10 @@ func (interpreter *Interpreter) VisitGroupingExpr(expr GroupingExpr, env *Enviro
 }
 
 func (interpreter *Interpreter) VisitIntLiteralExpr(expr IntLiteralExpr, env *Environment) (RTValue, error) {
+	if expr.Big != nil {
+		return NewRTBigInt(expr.Pos, expr.Big, env), nil
+	}
+
 	return NewRTInt(expr.Pos, expr.Value, env), nil
 }
 
@@ -325,6 +561,14 @@ func (interpreter *Interpreter) VisitBoolLiteralExpr(expr BoolLiteralExpr, env *
 	return NewRTBool(expr.Pos, expr.Value, env), nil
 }
 
+func (interpreter *Interpreter) VisitStringLiteralExpr(expr StringLiteralExpr, env *Environment) (RTValue, error) {
+	return NewRTString(expr.Pos, expr.Value, env), nil
+}
+
+func (interpreter *Interpreter) VisitNullLiteralExpr(expr NullLiteralExpr, env *Environment) (RTValue, error) {
+	return NewRTNull(), nil
+}
+
 func (interpreter *Interpreter) VisitVarAccessExpr(expr VarAccessExpr, env *Environment) (RTValue, error) {
 	val, err := env.Get(expr.Value, expr.Pos, env)
 	if err != nil {
@@ -335,13 +579,39 @@ func (interpreter *Interpreter) VisitVarAccessExpr(expr VarAccessExpr, env *Envi
 }
 
 func (interpreter *Interpreter) VisitVarAssignmentExpr(expr VarAssignmentExpr, env *Environment) (RTValue, error) {
-	if expr.Object == nil {
+	if expr.Pattern != nil {
+		val, err := interpreter.evaluate(expr.Value, env)
+		if err != nil {
+			return nil, err
+		}
+
+		err = NewDestructurer(interpreter, false, true).Destructure(expr.Pattern, val, env)
+		if err != nil {
+			return nil, err
+		}
+
+		return val, nil
+	} else if expr.Object == nil {
 		val, err := interpreter.evaluate(expr.Value, env)
 		if err != nil {
 			return nil, err
 		}
 
-		return env.Set(expr.Name, val, env, expr.Pos)
+		if expr.Op == nil {
+			return env.Set(expr.Name, val, env, expr.Pos)
+		}
+
+		var current RTValue
+		result, err := env.Update(expr.Name, func(value RTValue) (RTValue, error) {
+			current = value
+
+			return interpreter.binaryOperation(current, val, *expr.Op, expr.Pos)
+		}, env, expr.Pos)
+		if err != nil {
+			return nil, err
+		}
+
+		return interpreter.assignmentResult(current, result, expr.Postfix), nil
 	} else {
 		left, err := interpreter.evaluate(expr.Object, env)
 		if err != nil {
@@ -353,10 +623,37 @@ func (interpreter *Interpreter) VisitVarAssignmentExpr(expr VarAssignmentExpr, e
 			return nil, err
 		}
 
-		return left.SetAttribute(expr.Name, val, expr.Pos)
+		if expr.Op == nil {
+			return left.SetAttribute(expr.Name, val, expr.Pos)
+		}
+
+		current, err := left.Dot(*NewToken(IDENTIFIER, expr.Name, expr.Pos), expr.Pos)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := interpreter.binaryOperation(current, val, *expr.Op, expr.Pos)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = left.SetAttribute(expr.Name, result, expr.Pos)
+		if err != nil {
+			return nil, err
+		}
+
+		return interpreter.assignmentResult(current, result, expr.Postfix), nil
 	}
 }
 
+func (interpreter *Interpreter) assignmentResult(current RTValue, result RTValue, postfix bool) RTValue {
+	if postfix {
+		return current
+	}
+
+	return result
+}
+
 func (interpreter *Interpreter) VisitDotExpr(expr DotExpr, env *Environment) (RTValue, error) {
 	left, err := interpreter.evaluate(expr.Left, env)
 	if err != nil {
@@ -377,24 +674,714 @@ func (interpreter *Interpreter) VisitCallExpr(expr CallExpr, env *Environment) (
 		return nil, err
 	}
 
+	arguments, namedArguments, err := interpreter.arguments(expr.Arguments, env)
+	if err != nil {
+		return nil, err
+	}
+
+	interpreter.inFunc += 1
+	interpreter.callerEnv = env
+
+	val, err := function.Call(arguments, namedArguments, expr.Pos, interpreter)
+	if err != nil {
+		return nil, err
+	}
+
+	interpreter.inFunc -= 1
+
+	return val, nil
+}
+
+func (interpreter *Interpreter) arguments(args []Argument, env *Environment) ([]RTValue, []NamedArgument, error) {
 	arguments := make([]RTValue, 0)
-	for _, arg := range expr.Arguments {
-		argVisited, err := interpreter.evaluate(arg, env)
+	namedArguments := make([]NamedArgument, 0)
+	for _, arg := range args {
+		argVisited, err := interpreter.evaluate(arg.Value, env)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if arg.Spread {
+			next, err := argVisited.Iter(arg.Value.GetPosition(), interpreter)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for {
+				value, ok, err := next()
+				if err != nil {
+					return nil, nil, err
+				}
+
+				if !ok {
+					break
+				}
+
+				arguments = append(arguments, value)
+			}
+		} else if arg.Name != nil {
+			namedArguments = append(namedArguments, NamedArgument{Name: arg.Name.Value, Value: argVisited, Pos: arg.Name.Pos})
+		} else {
+			arguments = append(arguments, argVisited)
+		}
+	}
+
+	return arguments, namedArguments, nil
+}
+
+func (interpreter *Interpreter) VisitSpawnExpr(expr SpawnExpr, env *Environment) (RTValue, error) {
+	function, err := interpreter.evaluate(expr.Call.Function, env)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments, namedArguments, err := interpreter.arguments(expr.Call.Arguments, env)
+	if err != nil {
+		return nil, err
+	}
+
+	task := NewRTTask(taskName(function), expr.Pos, env)
+	task.run(function, arguments, namedArguments, expr.Call.Pos, interpreter, env)
+
+	return task, nil
+}
+
+func (interpreter *Interpreter) VisitAwaitExpr(expr AwaitExpr, env *Environment) (RTValue, error) {
+	value, err := interpreter.evaluate(expr.Value, env)
+	if err != nil {
+		return nil, err
+	}
+
+	task, ok := value.(*RTTask)
+	if !ok {
+		return nil, NewRuntimeError(
+			VALUE_ERROR,
+			fmt.Sprintf("can only await a value of type '%s' but got '%s' with value of '%s'", RTT_TASK, value.GetType(), value.ValueToString()),
+			"Use spawn to start a task, for example 'await spawn f(x)'",
+			expr.Pos,
+			env,
+		)
+	}
+
+	return task.Await(expr.Pos, env)
+}
+
+func (interpreter *Interpreter) VisitListLiteralExpr(expr ListLiteralExpr, env *Environment) (RTValue, error) {
+	values := make([]RTValue, 0, len(expr.Elements))
+	for _, element := range expr.Elements {
+		value, err := interpreter.evaluate(element, env)
 		if err != nil {
 			return nil, err
 		}
 
-		arguments = append(arguments, argVisited)
+		values = append(values, value)
 	}
 
-	interpreter.inFunc += 1
+	return NewRTList(expr.Pos, values, env), nil
+}
+
+func (interpreter *Interpreter) VisitTupleLiteralExpr(expr TupleLiteralExpr, env *Environment) (RTValue, error) {
+	values := make([]RTValue, 0, len(expr.Elements))
+	for _, element := range expr.Elements {
+		value, err := interpreter.evaluate(element, env)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return NewRTTuple(expr.Pos, values, env), nil
+}
 
-	val, err := function.Call(arguments, expr.Pos, interpreter)
+func (interpreter *Interpreter) VisitIndexExpr(expr IndexExpr, env *Environment) (RTValue, error) {
+	object, err := interpreter.evaluate(expr.Object, env)
 	if err != nil {
 		return nil, err
 	}
 
-	interpreter.inFunc -= 1
+	index, err := interpreter.evaluate(expr.Index, env)
+	if err != nil {
+		return nil, err
+	}
 
-	return val, nil
+	return object.Index(index, expr.Pos)
+}
+
+func (interpreter *Interpreter) VisitSliceExpr(expr SliceExpr, env *Environment) (RTValue, error) {
+	object, err := interpreter.evaluate(expr.Object, env)
+	if err != nil {
+		return nil, err
+	}
+
+	var start RTValue = NewRTNull()
+	if expr.Start != nil {
+		start, err = interpreter.evaluate(expr.Start, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var end RTValue = NewRTNull()
+	if expr.End != nil {
+		end, err = interpreter.evaluate(expr.End, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return object.Slice(start, end, expr.Pos)
+}
+
+func (interpreter *Interpreter) VisitIndexAssignmentExpr(expr IndexAssignmentExpr, env *Environment) (RTValue, error) {
+	object, err := interpreter.evaluate(expr.Object, env)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := interpreter.evaluate(expr.Index, env)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := interpreter.evaluate(expr.Value, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if expr.Op == nil {
+		return object.SetIndex(index, val, expr.Pos)
+	}
+
+	current, err := object.Index(index, expr.Pos)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := interpreter.binaryOperation(current, val, *expr.Op, expr.Pos)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = object.SetIndex(index, result, expr.Pos)
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.assignmentResult(current, result, expr.Postfix), nil
+}
+
+func (interpreter *Interpreter) VisitMapLiteralExpr(expr MapLiteralExpr, env *Environment) (RTValue, error) {
+	rTMap := NewRTMap(expr.Pos, env)
+
+	for index := range expr.Keys {
+		key, err := interpreter.evaluate(expr.Keys[index], env)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := interpreter.evaluate(expr.Values[index], env)
+		if err != nil {
+			return nil, err
+		}
+
+		err = rTMap.Set(key, value, expr.Keys[index].GetPosition())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rTMap, nil
+}
+
+func (interpreter *Interpreter) VisitThisExpr(expr ThisExpr, env *Environment) (RTValue, error) {
+	return env.Get("this", expr.Pos, env)
+}
+
+func (interpreter *Interpreter) VisitSuperExpr(expr SuperExpr, env *Environment) (RTValue, error) {
+	superClass, err := env.Get("super", expr.Pos, env)
+	if err != nil {
+		return nil, err
+	}
+
+	this, err := env.Get("this", expr.Pos, env)
+	if err != nil {
+		return nil, err
+	}
+
+	method := superClass.(*RTClass).FindMethod(expr.Method.Value)
+	if method == nil {
+		return nil, NewInvalidAttributeRTError(superClass, expr.Method, expr.Pos, env)
+	}
+
+	return method.Bind(this), nil
+}
+
+func (interpreter *Interpreter) VisitFunctionExpr(expr FunctionExpr, env *Environment) (RTValue, error) {
+	rTFunc := NewRTFunction("anonymous", expr.Parameters, expr.Block, expr.Pos, env)
+	rTFunc.Generator = expr.Generator
+
+	return rTFunc, nil
+}
+
+func (interpreter *Interpreter) VisitConditionalExpr(expr ConditionalExpr, env *Environment) (RTValue, error) {
+	condition, err := interpreter.evaluate(expr.Condition, env)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionBool, err := condition.ToBool(expr.Condition.GetPosition())
+	if err != nil {
+		return nil, err
+	}
+
+	if conditionBool.GetValue() == true {
+		return interpreter.evaluate(expr.Then, env)
+	}
+
+	return interpreter.evaluate(expr.Else, env)
+}
+
+func (interpreter *Interpreter) VisitStringifyExpr(expr StringifyExpr, env *Environment) (RTValue, error) {
+	value, err := interpreter.evaluate(expr.Value, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTString(expr.Pos, value.ValueToString(), env), nil
+}
+
+func (interpreter *Interpreter) VisitMatchStmt(stmt MatchStmt, env *Environment) (RTValue, error) {
+	value, err := interpreter.evaluate(stmt.Value, env)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, matchCase := range stmt.Cases {
+		for _, pattern := range matchCase.Patterns {
+			caseEnv := NewEnvironment(env, "", matchCase.Pos.Start.Ln, matchCase.Pos.File.Name, false)
+
+			matched, err := pattern.Accept(interpreter, value, caseEnv)
+			if err != nil {
+				return nil, err
+			}
+
+			if !matched {
+				continue
+			}
+
+			if matchCase.Guard != nil {
+				guard, err := interpreter.evaluate(matchCase.Guard, caseEnv)
+				if err != nil {
+					return nil, err
+				}
+
+				guardBool, err := guard.ToBool(matchCase.Guard.GetPosition())
+				if err != nil {
+					return nil, err
+				}
+
+				if guardBool.GetValue() == false {
+					continue
+				}
+			}
+
+			_, err = interpreter.execute(matchCase.Statement, caseEnv)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (interpreter *Interpreter) VisitSelectStmt(stmt SelectStmt, env *Environment) (RTValue, error) {
+	cases := make([]channelCase, 0, len(stmt.Cases))
+	for _, selectCase := range stmt.Cases {
+		value, err := interpreter.evaluate(selectCase.Channel, env)
+		if err != nil {
+			return nil, err
+		}
+
+		channel, ok := value.(*RTChannel)
+		if !ok {
+			return nil, NewRuntimeError(
+				VALUE_ERROR,
+				fmt.Sprintf("a select case expected a value of type '%s' but got '%s' with value of '%s'", RTT_CHANNEL, value.GetType(), value.ValueToString()),
+				"",
+				selectCase.Channel.GetPosition(),
+				env,
+			)
+		}
+
+		var sent RTValue
+		if selectCase.Send {
+			sent, err = interpreter.evaluate(selectCase.Value, env)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		cases = append(cases, channelCase{channel: channel, send: selectCase.Send, value: sent})
+	}
+
+	chosen, received, ok, err := selectChannels(cases, stmt.Else == nil, stmt.Pos, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if chosen == -1 {
+		_, err = interpreter.execute(stmt.Else, env)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	selectCase := stmt.Cases[chosen]
+	caseEnv := NewEnvironment(env, "", selectCase.Pos.Start.Ln, selectCase.Pos.File.Name, false)
+
+	if selectCase.Name != nil {
+		var value RTValue = NewRTNull()
+		if ok {
+			value = received
+		}
+
+		err = caseEnv.Declare(false, selectCase.Name.Value, value, selectCase.Name.Pos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = interpreter.execute(selectCase.Statement, caseEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (interpreter *Interpreter) VisitLiteralPattern(pattern LiteralPattern, value RTValue, env *Environment) (bool, error) {
+	literal, err := interpreter.evaluate(pattern.Value, env)
+	if err != nil {
+		return false, err
+	}
+
+	if literal.GetType() != value.GetType() && !(isNumber(literal) && isNumber(value)) {
+		return false, nil
+	}
+
+	equals, err := value.Equals(literal, pattern.Pos)
+	if err != nil {
+		return false, err
+	}
+
+	return equals.GetValue() == true, nil
+}
+
+func (interpreter *Interpreter) VisitWildcardPattern(pattern WildcardPattern, value RTValue, env *Environment) (bool, error) {
+	return true, nil
+}
+
+func (interpreter *Interpreter) VisitBindingPattern(pattern BindingPattern, value RTValue, env *Environment) (bool, error) {
+	err := env.Declare(false, pattern.Name, value, pattern.Pos)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (interpreter *Interpreter) VisitListPattern(pattern ListPattern, value RTValue, env *Environment) (bool, error) {
+	list, ok := value.(*RTList)
+	if !ok {
+		return false, nil
+	}
+
+	values := list.values()
+
+	length := len(pattern.Before) + len(pattern.After)
+	if len(values) < length || (pattern.Rest == nil && len(values) != length) {
+		return false, nil
+	}
+
+	for index, elementPattern := range pattern.Before {
+		matched, err := elementPattern.Accept(interpreter, values[index], env)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	afterStart := len(values) - len(pattern.After)
+	for index, elementPattern := range pattern.After {
+		matched, err := elementPattern.Accept(interpreter, values[afterStart+index], env)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	if pattern.Rest != nil && pattern.Rest.Value != "_" {
+		rest := make([]RTValue, afterStart-len(pattern.Before))
+		copy(rest, values[len(pattern.Before):afterStart])
+
+		err := env.Declare(false, pattern.Rest.Value, NewRTList(pattern.Rest.Pos, rest, env), pattern.Rest.Pos)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (interpreter *Interpreter) VisitTuplePattern(pattern TuplePattern, value RTValue, env *Environment) (bool, error) {
+	tuple, ok := value.(*RTTuple)
+	if !ok || len(tuple.Values) != len(pattern.Elements) {
+		return false, nil
+	}
+
+	for index, elementPattern := range pattern.Elements {
+		matched, err := elementPattern.Accept(interpreter, tuple.Values[index], env)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (interpreter *Interpreter) VisitMapPattern(pattern MapPattern, value RTValue, env *Environment) (bool, error) {
+	rTMap, ok := value.(*RTMap)
+	if !ok {
+		return false, nil
+	}
+
+	for index, keyExpr := range pattern.Keys {
+		key, err := interpreter.evaluate(keyExpr, env)
+		if err != nil {
+			return false, err
+		}
+
+		entry, found, err := rTMap.Get(key, keyExpr.GetPosition())
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			return false, nil
+		}
+
+		matched, err := pattern.Values[index].Accept(interpreter, entry, env)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (interpreter *Interpreter) VisitClassPattern(pattern ClassPattern, value RTValue, env *Environment) (bool, error) {
+	classValue, err := interpreter.evaluate(pattern.Class, env)
+	if err != nil {
+		return false, err
+	}
+
+	class, ok := classValue.(*RTClass)
+	if !ok {
+		return false, NewRuntimeError(
+			INVALID_PATTERN_ERROR,
+			fmt.Sprintf("'%s' is of type '%s' and can not be used as a class pattern", pattern.Class.Value, classValue.GetType()),
+			"",
+			pattern.Class.Pos,
+			env,
+		)
+	}
+
+	instance, ok := value.(*RTInstance)
+	if !ok || !instance.Class.IsSubclassOf(class) {
+		return false, nil
+	}
+
+	for index, field := range pattern.Fields {
+		fieldValue, ok := instance.Field(field.Value)
+		if !ok {
+			return false, nil
+		}
+
+		matched, err := pattern.Patterns[index].Accept(interpreter, fieldValue, env)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (interpreter *Interpreter) VisitDefaultPattern(pattern DefaultPattern, value RTValue, env *Environment) (bool, error) {
+	return pattern.Pattern.Accept(interpreter, value, env)
+}
+
+func isNumber(value RTValue) bool {
+	return value.GetType() == RTT_INT || value.GetType() == RTT_FLOAT
+}
+
+func (interpreter *Interpreter) VisitThrowStmt(stmt ThrowStmt, env *Environment) (RTValue, error) {
+	value, err := interpreter.evaluate(stmt.Value, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if errorValue, ok := value.(*RTErrorValue); ok {
+		return nil, errorValue.Err
+	}
+
+	return nil, NewThrownRTError(value, stmt.Pos, env)
+}
+
+func (interpreter *Interpreter) VisitTryStmt(stmt TryStmt, env *Environment) (RTValue, error) {
+	inLoop, inFunc := interpreter.inLoop, interpreter.inFunc
+
+	_, err := interpreter.execute(stmt.Statement, env)
+
+	if rTError, ok := err.(*RTError); ok {
+		interpreter.inLoop, interpreter.inFunc = inLoop, inFunc
+
+		for _, catchClause := range stmt.Catches {
+			matched, catchErr := interpreter.catches(catchClause, rTError, env)
+			if catchErr != nil {
+				err = catchErr
+				break
+			}
+
+			if !matched {
+				continue
+			}
+
+			catchEnv := NewEnvironment(env, "", catchClause.Pos.Start.Ln, catchClause.Pos.File.Name, false)
+
+			if catchClause.Name != nil {
+				err = catchEnv.Declare(false, catchClause.Name.Value, NewRTErrorValue(rTError, catchClause.Name.Pos, catchEnv), catchClause.Name.Pos)
+				if err != nil {
+					break
+				}
+			}
+
+			_, err = interpreter.execute(catchClause.Statement, catchEnv)
+			break
+		}
+	}
+
+	if stmt.Finally != nil && err != errGeneratorAbandoned {
+		returnBlock, returnVal := interpreter.returnBlock, interpreter.returnVal
+		breakLoop, continueLoop, loopLabel := interpreter.breakLoop, interpreter.continueLoop, interpreter.loopLabel
+
+		interpreter.returnBlock, interpreter.returnVal = false, nil
+		interpreter.breakLoop, interpreter.continueLoop, interpreter.loopLabel = false, false, ""
+
+		_, finallyErr := interpreter.execute(stmt.Finally, env)
+		if finallyErr != nil {
+			return nil, finallyErr
+		}
+
+		if !interpreter.returnBlock && !interpreter.breakLoop && !interpreter.continueLoop {
+			interpreter.returnBlock, interpreter.returnVal = returnBlock, returnVal
+			interpreter.breakLoop, interpreter.continueLoop, interpreter.loopLabel = breakLoop, continueLoop, loopLabel
+		} else {
+			return nil, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (interpreter *Interpreter) catches(catchClause CatchClause, rTError *RTError, env *Environment) (bool, error) {
+	if len(catchClause.Types) == 0 {
+		return true, nil
+	}
+
+	for _, typeExpr := range catchClause.Types {
+		catchType, err := interpreter.evaluate(typeExpr, env)
+		if err != nil {
+			return false, err
+		}
+
+		switch catchType := catchType.(type) {
+		case *RTString:
+			if catchType.Value == string(rTError.ErrType) {
+				return true, nil
+			}
+		case *RTClass:
+			if instance, ok := rTError.value.(*RTInstance); ok && instance.Class.IsSubclassOf(catchType) {
+				return true, nil
+			}
+		default:
+			return false, NewRuntimeError(
+				INVALID_CATCH_TYPE_ERROR,
+				fmt.Sprintf("unable to catch errors by a value of type '%s'", catchType.GetType()),
+				"Catch types must be error types like 'VALUE_ERROR' or classes",
+				typeExpr.GetPosition(),
+				env,
+			)
+		}
+	}
+
+	return false, nil
+}
+
+func (interpreter *Interpreter) VisitYieldStmt(stmt YieldStmt, env *Environment) (RTValue, error) {
+	var value RTValue = NewRTNull()
+	if stmt.Value != nil {
+		var err error
+
+		value, err = interpreter.evaluate(stmt.Value, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if interpreter.generator == nil {
+		return nil, NewRuntimeError(
+			YIELD_OUTSIDE_OF_FUNCTION_ERROR,
+			"yield statement found outside of a generator",
+			"",
+			stmt.Pos,
+			env,
+		)
+	}
+
+	return nil, interpreter.generator.yield(value)
+}
+
+func (interpreter *Interpreter) VisitImportStmt(stmt ImportStmt, env *Environment) (RTValue, error) {
+	module, err := interpreter.modules.Load(stmt.Path.Value, interpreter.importing, stmt.Path.Pos, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.Names == nil {
+		return nil, env.Declare(false, stmt.Alias.Value, module, stmt.Alias.Pos)
+	}
+
+	for _, name := range stmt.Names {
+		value, ok := module.Members.Lookup(name.Value)
+		if !ok {
+			return nil, NewRuntimeError(
+				IMPORT_ERROR,
+				fmt.Sprintf("the module '%s' has no member called '%s'", stmt.Path.Value, name.Value),
+				"",
+				name.Pos,
+				env,
+			)
+		}
+
+		err = env.Declare(false, name.Value, value, name.Pos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
 }
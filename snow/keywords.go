@@ -2,11 +2,16 @@ package snow
 
 var Keywords = map[string]TokenType{
 	"not":      NOT,
+	"and":      AND,
+	"or":       OR,
 	"true":     TRUE,
 	"false":    FALSE,
+	"null":     NULL,
 	"var":      VAR,
 	"const":    CONST,
 	"while":    WHILE,
+	"for":      FOR,
+	"in":       IN,
 	"continue": CONTINUE,
 	"break":    BREAK,
 	"function": FUNCTION,
@@ -14,4 +19,20 @@ var Keywords = map[string]TokenType{
 	"if":       IF,
 	"elif":     ELIF,
 	"else":     ELSE,
+	"class":    CLASS,
+	"this":     THIS,
+	"super":    SUPER,
+	"match":    MATCH,
+	"case":     CASE,
+	"try":      TRY,
+	"catch":    CATCH,
+	"finally":  FINALLY,
+	"throw":    THROW,
+	"import":   IMPORT,
+	"from":     FROM,
+	"as":       AS,
+	"yield":    YIELD,
+	"spawn":    SPAWN,
+	"await":    AWAIT,
+	"select":   SELECT,
 }
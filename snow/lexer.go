@@ -2,13 +2,26 @@ package snow
 
 import (
 	"fmt"
+	"math/big"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
 )
 
+type stringContext struct {
+	quote  rune
+	triple bool
+	start  SimplePos
+	depth  int
+}
+
 type Lexer struct {
-	pos         SimplePos
-	file        *File
-	currentChar byte
-	end         bool
+	pos            SimplePos
+	file           *File
+	currentChar    rune
+	size           int
+	end            bool
+	interpolations []stringContext
 }
 
 func NewLexer(file *File) *Lexer {
@@ -19,32 +32,54 @@ func NewLexer(file *File) *Lexer {
 }
 
 func (lexer *Lexer) advance() {
-	lexer.pos.Idx += 1
-	lexer.pos.Col += 1
-
-	if lexer.currentChar == '\n' {
-		lexer.pos.Ln += 1
+	if lexer.pos.Idx < 0 {
+		lexer.pos.Idx = 0
 		lexer.pos.Col = 0
+	} else {
+		lexer.pos.Idx += lexer.size
+
+		switch lexer.currentChar {
+		case '\n':
+			lexer.pos.Ln += 1
+			lexer.pos.Col = 0
+		case '\t':
+			lexer.pos.Col += lexer.file.TabWidth - lexer.pos.Col%lexer.file.TabWidth
+		default:
+			lexer.pos.Col += 1
+		}
 	}
 
 	if lexer.pos.Idx >= len(lexer.file.Code) {
 		lexer.end = true
 		lexer.currentChar = 0x0
+		lexer.size = 0
 	} else {
-		lexer.currentChar = lexer.file.Code[lexer.pos.Idx]
+		lexer.currentChar, lexer.size = utf8.DecodeRuneInString(lexer.file.Code[lexer.pos.Idx:])
 	}
 }
 
-func (lexer *Lexer) peek() byte {
-	if lexer.pos.Idx+1 >= len(lexer.file.Code) {
+func (lexer *Lexer) peek() rune {
+	return lexer.peekAt(1)
+}
+
+func (lexer *Lexer) peekAt(offset int) rune {
+	idx := lexer.pos.Idx + lexer.size
+	for ; offset > 1 && idx < len(lexer.file.Code); offset-- {
+		_, size := utf8.DecodeRuneInString(lexer.file.Code[idx:])
+		idx += size
+	}
+
+	if idx >= len(lexer.file.Code) {
 		return 0x0
-	} else {
-		return lexer.file.Code[lexer.pos.Idx+1]
 	}
+
+	c, _ := utf8.DecodeRuneInString(lexer.file.Code[idx:])
+
+	return c
 }
 
 func (lexer *Lexer) isPeekEnd() bool {
-	if lexer.pos.Idx+1 >= len(lexer.file.Code) {
+	if lexer.pos.Idx+lexer.size >= len(lexer.file.Code) {
 		return true
 	} else {
 		return false
@@ -55,16 +90,29 @@ func (lexer *Lexer) createSimpleToken(tType TokenType) *Token {
 	return NewToken(tType, "", *lexer.pos.AsSEPos(lexer.file))
 }
 
-func (lexer *Lexer) isDigit(c byte) bool {
+func (lexer *Lexer) isDigit(c rune) bool {
 	return c >= '0' && c <= '9'
 }
 
-func (lexer *Lexer) isAlpha(c byte) bool {
-	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+func (lexer *Lexer) isAlpha(c rune) bool {
+	return unicode.IsLetter(c) || c == '_'
 }
 
-func (lexer *Lexer) isAlphaDigit(c byte) bool {
-	return lexer.isAlpha(c) || lexer.isDigit(c)
+func (lexer *Lexer) isAlphaDigit(c rune) bool {
+	return lexer.isAlpha(c) || unicode.IsDigit(c) || unicode.IsMark(c)
+}
+
+func (lexer *Lexer) followsValue(tokens []Token) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+
+	switch tokens[len(tokens)-1].TType {
+	case IDENTIFIER, INT, FLOAT, STRING, STRING_END, RPAREN, RBRACKET, RCURLYBRACKET, THIS:
+		return true
+	}
+
+	return false
 }
 
 func (lexer *Lexer) Tokenize() ([]Token, []error) {
@@ -80,10 +128,7 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 		case '\n':
 			tokens = append(tokens, *lexer.createSimpleToken(NEWLINE))
 			lexer.advance()
-		case ' ':
-			lexer.advance()
-		case '\t':
-			lexer.pos.Col += 2
+		case ' ', '\t', '\r':
 			lexer.advance()
 		case '#':
 			_, err := lexer.makeComment()
@@ -91,22 +136,163 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 				errors = append(errors, err)
 			}
 		case '.':
-			tokens = append(tokens, *lexer.createSimpleToken(DOT))
-			lexer.advance()
+			if lexer.peek() == '.' && lexer.peekAt(2) == '.' {
+				lexer.advance()
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					ELLIPSIS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else if lexer.isDigit(lexer.peek()) && !lexer.followsValue(tokens) {
+				tok, err := lexer.makeNumber()
+				if err == nil {
+					tokens = append(tokens, tok)
+				} else {
+					errors = append(errors, err)
+				}
+			} else {
+				tokens = append(tokens, *lexer.createSimpleToken(DOT))
+				lexer.advance()
+			}
 		case ',':
 			tokens = append(tokens, *lexer.createSimpleToken(COMMA))
 			lexer.advance()
-		case '+':
-			tokens = append(tokens, *lexer.createSimpleToken(PLUS))
+		case ':':
+			tokens = append(tokens, *lexer.createSimpleToken(COLON))
 			lexer.advance()
-		case '-':
-			tokens = append(tokens, *lexer.createSimpleToken(DASH))
+		case '?':
+			tokens = append(tokens, *lexer.createSimpleToken(QUESTION))
 			lexer.advance()
+		case '+':
+			if !lexer.end && lexer.peek() == '=' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					PLUS_EQUALS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '+' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					DOUBLE_PLUS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else {
+				tokens = append(tokens, *lexer.createSimpleToken(PLUS))
+				lexer.advance()
+			}
+		case '-':
+			if !lexer.end && lexer.peek() == '=' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					DASH_EQUALS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '-' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					DOUBLE_DASH,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else {
+				tokens = append(tokens, *lexer.createSimpleToken(DASH))
+				lexer.advance()
+			}
 		case '*':
-			tokens = append(tokens, *lexer.createSimpleToken(STAR))
-			lexer.advance()
+			if !lexer.end && lexer.peek() == '=' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					STAR_EQUALS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '*' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					DOUBLE_STAR,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else {
+				tokens = append(tokens, *lexer.createSimpleToken(STAR))
+				lexer.advance()
+			}
 		case '/':
-			tokens = append(tokens, *lexer.createSimpleToken(SLASH))
+			if !lexer.end && lexer.peek() == '=' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					SLASH_EQUALS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '/' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					DOUBLE_SLASH,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else {
+				tokens = append(tokens, *lexer.createSimpleToken(SLASH))
+				lexer.advance()
+			}
+		case '%':
+			if !lexer.end && lexer.peek() == '=' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					PERCENT_EQUALS,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
+				lexer.advance()
+			} else {
+				tokens = append(tokens, *lexer.createSimpleToken(PERCENT))
+				lexer.advance()
+			}
+		case '&':
+			tokens = append(tokens, *lexer.createSimpleToken(AMPERSAND))
+			lexer.advance()
+		case '|':
+			tokens = append(tokens, *lexer.createSimpleToken(PIPE))
+			lexer.advance()
+		case '^':
+			tokens = append(tokens, *lexer.createSimpleToken(CARET))
+			lexer.advance()
+		case '~':
+			tokens = append(tokens, *lexer.createSimpleToken(TILDE))
 			lexer.advance()
 		case '(':
 			tokens = append(tokens, *lexer.createSimpleToken(LPAREN))
@@ -115,10 +301,38 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 			tokens = append(tokens, *lexer.createSimpleToken(RPAREN))
 			lexer.advance()
 		case '{':
+			if len(lexer.interpolations) > 0 {
+				lexer.interpolations[len(lexer.interpolations)-1].depth++
+			}
+
 			tokens = append(tokens, *lexer.createSimpleToken(LCURLYBRACKET))
 			lexer.advance()
 		case '}':
-			tokens = append(tokens, *lexer.createSimpleToken(RCURLYBRACKET))
+			if len(lexer.interpolations) > 0 && lexer.interpolations[len(lexer.interpolations)-1].depth == 0 {
+				context := lexer.interpolations[len(lexer.interpolations)-1]
+				lexer.interpolations = lexer.interpolations[:len(lexer.interpolations)-1]
+
+				lexer.advance()
+
+				tok, err := lexer.readString(context, startPos, false, true)
+				if err == nil {
+					tokens = append(tokens, tok)
+				} else {
+					errors = append(errors, err)
+				}
+			} else {
+				if len(lexer.interpolations) > 0 {
+					lexer.interpolations[len(lexer.interpolations)-1].depth--
+				}
+
+				tokens = append(tokens, *lexer.createSimpleToken(RCURLYBRACKET))
+				lexer.advance()
+			}
+		case '[':
+			tokens = append(tokens, *lexer.createSimpleToken(LBRACKET))
+			lexer.advance()
+		case ']':
+			tokens = append(tokens, *lexer.createSimpleToken(RBRACKET))
 			lexer.advance()
 		case '=':
 			if !lexer.end && lexer.peek() == '=' {
@@ -130,6 +344,16 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 					*startPos.CreateSEPos(lexer.pos, lexer.file),
 				))
 
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '>' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					ARROW,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
 				lexer.advance()
 			} else {
 				tokens = append(tokens, *lexer.createSimpleToken(SINGLE_EQUALS))
@@ -145,6 +369,16 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 					*startPos.CreateSEPos(lexer.pos, lexer.file),
 				))
 
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '<' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					LEFT_SHIFT,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
 				lexer.advance()
 			} else {
 				tokens = append(tokens, *lexer.createSimpleToken(LESS_THAN))
@@ -160,6 +394,16 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 					*startPos.CreateSEPos(lexer.pos, lexer.file),
 				))
 
+				lexer.advance()
+			} else if !lexer.end && lexer.peek() == '>' {
+				lexer.advance()
+
+				tokens = append(tokens, *NewToken(
+					RIGHT_SHIFT,
+					"",
+					*startPos.CreateSEPos(lexer.pos, lexer.file),
+				))
+
 				lexer.advance()
 			} else {
 				tokens = append(tokens, *lexer.createSimpleToken(GREATER_THAN))
@@ -174,7 +418,14 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 					errors = append(errors, err)
 				}
 			} else if lexer.currentChar == '"' || lexer.currentChar == '\'' {
-				tok, err := lexer.makeString()
+				tok, err := lexer.makeString(false)
+				if err == nil {
+					tokens = append(tokens, tok)
+				} else {
+					errors = append(errors, err)
+				}
+			} else if lexer.currentChar == 'r' && (lexer.peek() == '"' || lexer.peek() == '\'') {
+				tok, err := lexer.makeString(true)
 				if err == nil {
 					tokens = append(tokens, tok)
 				} else {
@@ -210,6 +461,15 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 		}
 	}
 
+	for _, context := range lexer.interpolations {
+		errors = append(errors, *NewSnowError(
+			UNTERMINATED_STRING_ERROR,
+			"the interpolation in the string was never closed",
+			"Add a '}' to close the interpolation",
+			*context.start.CreateSEPos(lexer.pos, lexer.file),
+		))
+	}
+
 	tokens = append(tokens, *lexer.createSimpleToken(EOF))
 
 	return tokens, errors
@@ -217,32 +477,40 @@ func (lexer *Lexer) Tokenize() ([]Token, []error) {
 
 func (lexer *Lexer) makeNumber() (Token, error) {
 	startPos := lexer.pos
-	endPos := startPos
-	numberStr := string(lexer.currentChar)
-	isFloat := false
-
-	lexer.advance()
 
-	for !lexer.end && (lexer.isDigit(lexer.currentChar) || (lexer.currentChar == '.' && lexer.isDigit(lexer.peek()))) {
-		if lexer.currentChar == '.' {
-			if isFloat && !lexer.isDigit(lexer.peek()) {
-				return *NewToken(
-					FLOAT,
-					numberStr,
-					*startPos.CreateSEPos(endPos, lexer.file),
-				), nil
-			} else if isFloat {
-				break
-			}
-
-			isFloat = true
+	if lexer.currentChar == '0' {
+		switch lexer.peek() {
+		case 'x', 'X':
+			return lexer.makeBaseNumber(16, "hexadecimal")
+		case 'o', 'O':
+			return lexer.makeBaseNumber(8, "octal")
+		case 'b', 'B':
+			return lexer.makeBaseNumber(2, "binary")
 		}
+	}
 
-		numberStr += string(lexer.currentChar)
+	numberStr, endPos, err := lexer.readDigits(10)
+	if err != nil {
+		return Token{}, err
+	}
 
-		endPos = lexer.pos
+	isFloat := false
 
+	if lexer.currentChar == '.' && lexer.isDigit(lexer.peek()) {
 		lexer.advance()
+
+		fraction, fractionEnd, err := lexer.readDigits(10)
+		if err != nil {
+			return Token{}, err
+		}
+
+		if numberStr == "" {
+			numberStr = "0"
+		}
+
+		numberStr += "." + fraction
+		endPos = fractionEnd
+		isFloat = true
 	}
 
 	if lexer.currentChar == '.' && (lexer.isPeekEnd() || lexer.peek() == '\n') {
@@ -268,7 +536,7 @@ func (lexer *Lexer) makeNumber() (Token, error) {
 	} else if isFloat && lexer.currentChar == '.' && lexer.isDigit(lexer.peek()) {
 		pos := lexer.pos
 
-		lexer.advance()
+		lexer.skipNumber()
 
 		return Token{}, NewSnowError(
 			MULTIPLE_DOTS_ERROR,
@@ -278,6 +546,39 @@ func (lexer *Lexer) makeNumber() (Token, error) {
 		)
 	}
 
+	if lexer.currentChar == 'e' || lexer.currentChar == 'E' {
+		exponentPos := lexer.pos
+		exponentEnd := exponentPos
+		exponent := "e"
+
+		lexer.advance()
+
+		if lexer.currentChar == '+' || lexer.currentChar == '-' {
+			exponent += string(lexer.currentChar)
+			exponentEnd = lexer.pos
+
+			lexer.advance()
+		}
+
+		digits, digitsEnd, err := lexer.readDigits(10)
+		if err != nil {
+			return Token{}, err
+		}
+
+		if digits == "" {
+			return Token{}, NewSnowError(
+				MISSING_DIGITS_ERROR,
+				"The exponent of a number needs at least one digit",
+				fmt.Sprintf("Add digits after the exponent: '%s%s1'", numberStr, exponent),
+				*exponentPos.CreateSEPos(exponentEnd, lexer.file),
+			)
+		}
+
+		numberStr += exponent + digits
+		endPos = digitsEnd
+		isFloat = true
+	}
+
 	if isFloat {
 		return *NewToken(
 			FLOAT,
@@ -295,15 +596,185 @@ func (lexer *Lexer) makeNumber() (Token, error) {
 	return tok, nil
 }
 
-func (lexer *Lexer) makeString() (Token, error) {
+func (lexer *Lexer) makeBaseNumber(base int, name string) (Token, error) {
+	startPos := lexer.pos
+
+	lexer.advance()
+
+	prefix := "0" + string(lexer.currentChar)
+	prefixPos := lexer.pos
+
+	lexer.advance()
+
+	digits, endPos, err := lexer.readDigits(base)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if !lexer.end && lexer.isAlphaDigit(lexer.currentChar) {
+		pos := lexer.pos
+		digit := lexer.currentChar
+
+		lexer.skipNumber()
+
+		return Token{}, NewSnowError(
+			INVALID_DIGIT_ERROR,
+			fmt.Sprintf("Invalid digit '%c' in %s number", digit, name),
+			"",
+			*pos.AsSEPos(lexer.file),
+		)
+	}
+
+	if digits == "" {
+		return Token{}, NewSnowError(
+			MISSING_DIGITS_ERROR,
+			fmt.Sprintf("A %s number needs at least one digit", name),
+			fmt.Sprintf("Add digits after the prefix: '%s0'", prefix),
+			*startPos.CreateSEPos(prefixPos, lexer.file),
+		)
+	}
+
+	value, _ := new(big.Int).SetString(digits, base)
+
+	return *NewToken(
+		INT,
+		value.String(),
+		*startPos.CreateSEPos(endPos, lexer.file),
+	), nil
+}
+
+func (lexer *Lexer) isBaseDigit(c rune, base int) bool {
+	switch base {
+	case 2:
+		return c == '0' || c == '1'
+	case 8:
+		return c >= '0' && c <= '7'
+	case 16:
+		return lexer.isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+	}
+
+	return lexer.isDigit(c)
+}
+
+func (lexer *Lexer) readDigits(base int) (string, SimplePos, error) {
+	digits := ""
+	endPos := lexer.pos
+
+	for !lexer.end && (lexer.isBaseDigit(lexer.currentChar, base) || lexer.currentChar == '_') {
+		if lexer.currentChar == '_' && (digits == "" || !lexer.isBaseDigit(lexer.peek(), base)) {
+			pos := lexer.pos
+
+			lexer.skipNumber()
+
+			return "", pos, NewSnowError(
+				INVALID_SEPARATOR_ERROR,
+				"Digit separators are only allowed between two digits",
+				"Remove the '_' or put it between two digits: '1_000'",
+				*pos.AsSEPos(lexer.file),
+			)
+		}
+
+		if lexer.currentChar != '_' {
+			digits += string(lexer.currentChar)
+		}
+
+		endPos = lexer.pos
+
+		lexer.advance()
+	}
+
+	return digits, endPos, nil
+}
+
+func (lexer *Lexer) skipNumber() {
+	for !lexer.end && (lexer.isAlphaDigit(lexer.currentChar) || lexer.currentChar == '.' && lexer.isDigit(lexer.peek())) {
+		lexer.advance()
+	}
+}
+
+func (lexer *Lexer) makeString(raw bool) (Token, error) {
 	startPos := lexer.pos
-	startChar := lexer.currentChar
+
+	if raw {
+		lexer.advance()
+	}
+
+	context := stringContext{
+		quote: lexer.currentChar,
+		start: startPos,
+	}
+
+	if lexer.peek() == context.quote && lexer.peekAt(2) == context.quote {
+		context.triple = true
+
+		lexer.advance()
+		lexer.advance()
+	}
+
+	lexer.advance()
+
+	if context.triple && lexer.currentChar == '\n' {
+		lexer.advance()
+	}
+
+	return lexer.readString(context, startPos, raw, false)
+}
+
+func (lexer *Lexer) isStringEnd(context stringContext) bool {
+	if lexer.currentChar != context.quote {
+		return false
+	}
+
+	if !context.triple {
+		return true
+	}
+
+	return lexer.peek() == context.quote && lexer.peekAt(2) == context.quote
+}
+
+func (lexer *Lexer) readString(context stringContext, startPos SimplePos, raw bool, interpolated bool) (Token, error) {
 	strValue := ""
 	endPos := startPos
+	var escapeErr error
 
-	lexer.advance()
+	for !lexer.end && !lexer.isStringEnd(context) && (context.triple || lexer.currentChar != '\n') {
+		if !raw && lexer.currentChar == '$' && lexer.peek() == '{' {
+			lexer.advance()
+
+			endPos = lexer.pos
+			lexer.advance()
+
+			context.depth = 0
+			lexer.interpolations = append(lexer.interpolations, context)
+
+			tType := STRING_START
+			if interpolated {
+				tType = STRING_MIDDLE
+			}
+
+			if escapeErr != nil {
+				return Token{}, escapeErr
+			}
+
+			return *NewToken(
+				tType,
+				strValue,
+				*startPos.CreateSEPos(endPos, lexer.file),
+			), nil
+		}
+
+		if !raw && lexer.currentChar == '\\' {
+			value, err := lexer.makeEscape()
+			if err != nil && escapeErr == nil {
+				escapeErr = err
+			}
+
+			strValue += value
+			endPos = lexer.pos
+
+			continue
+		}
 
-	for lexer.currentChar != startChar && !lexer.end && lexer.currentChar != '\n' {
 		strValue += string(lexer.currentChar)
 
 		endPos = lexer.pos
@@ -311,12 +782,17 @@ func (lexer *Lexer) makeString() (Token, error) {
 		lexer.advance()
 	}
 
-	if lexer.end || lexer.currentChar == '\n' {
+	if lexer.end || lexer.currentChar == '\n' && !context.triple {
+		quote := string(context.quote)
+		if context.triple {
+			quote = quote + quote + quote
+		}
+
 		str := ""
-		if startChar == '"' {
-			str = fmt.Sprintf("'\"%s\"'", strValue)
+		if context.quote == '"' {
+			str = fmt.Sprintf("'%s'", quote)
 		} else {
-			str = fmt.Sprintf("\"'%s'\"", strValue)
+			str = fmt.Sprintf("\"%s\"", quote)
 		}
 		return Token{}, NewSnowError(
 			UNTERMINATED_STRING_ERROR,
@@ -326,16 +802,113 @@ func (lexer *Lexer) makeString() (Token, error) {
 		)
 	}
 
+	if context.triple {
+		lexer.advance()
+		lexer.advance()
+	}
+
 	endPos = lexer.pos
 	lexer.advance()
 
+	if escapeErr != nil {
+		return Token{}, escapeErr
+	}
+
+	tType := STRING
+	if interpolated {
+		tType = STRING_END
+	}
+
 	return *NewToken(
-		STRING,
+		tType,
 		strValue,
 		*startPos.CreateSEPos(endPos, lexer.file),
 	), nil
 }
 
+func (lexer *Lexer) makeEscape() (string, error) {
+	startPos := lexer.pos
+
+	lexer.advance()
+
+	escape := lexer.currentChar
+	endPos := lexer.pos
+
+	if lexer.end {
+		return "", NewSnowError(
+			INVALID_ESCAPE_ERROR,
+			"the escape sequence was never finished",
+			"",
+			*startPos.AsSEPos(lexer.file),
+		)
+	}
+
+	lexer.advance()
+
+	switch escape {
+	case 'n':
+		return "\n", nil
+	case 't':
+		return "\t", nil
+	case 'r':
+		return "\r", nil
+	case '0':
+		return "\x00", nil
+	case '\\', '"', '\'', '$':
+		return string(escape), nil
+	case 'u':
+		if lexer.currentChar != '{' {
+			return "", NewSnowError(
+				INVALID_ESCAPE_ERROR,
+				"expected '{' after '\\u'",
+				"Unicode escapes are written like '\\u{1F600}'",
+				*startPos.CreateSEPos(endPos, lexer.file),
+			)
+		}
+
+		lexer.advance()
+
+		hex := ""
+		for !lexer.end && lexer.currentChar != '}' && lexer.currentChar != '\n' {
+			hex += string(lexer.currentChar)
+
+			lexer.advance()
+		}
+
+		endPos = lexer.pos
+
+		if lexer.currentChar != '}' {
+			return "", NewSnowError(
+				INVALID_ESCAPE_ERROR,
+				"the unicode escape was never closed",
+				"Unicode escapes are written like '\\u{1F600}'",
+				*startPos.CreateSEPos(endPos, lexer.file),
+			)
+		}
+
+		lexer.advance()
+
+		code, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return "", NewSnowError(
+				INVALID_ESCAPE_ERROR,
+				fmt.Sprintf("'%s' is not a valid unicode code point", hex),
+				"Unicode escapes take a hexadecimal code point like '\\u{1F600}'",
+				*startPos.CreateSEPos(endPos, lexer.file),
+			)
+		}
+
+		return string(rune(code)), nil
+	}
+
+	return string(escape), NewSnowError(
+		INVALID_ESCAPE_ERROR,
+		fmt.Sprintf("invalid escape sequence '\\%c'", escape),
+		"Valid escape sequences are '\\n', '\\t', '\\r', '\\0', '\\\\', '\\\"', '\\'', '\\$' and '\\u{...}'",
+		*startPos.CreateSEPos(endPos, lexer.file),
+	)
+}
+
 func (lexer *Lexer) makeIdentifierKeyword() (Token, error) {
 	startPos := lexer.pos
 	endPos := startPos
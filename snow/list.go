@@ -0,0 +1,596 @@
+package snow
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type RTList struct {
+	Pos         SEPos
+	Values      []RTValue
+	Environment *Environment
+	mutex       sync.RWMutex
+}
+
+func NewRTList(pos SEPos, values []RTValue, env *Environment) *RTList {
+	return &RTList{
+		Pos:         pos,
+		Values:      values,
+		Environment: env,
+	}
+}
+
+func (rTList *RTList) values() []RTValue {
+	rTList.mutex.RLock()
+	defer rTList.mutex.RUnlock()
+
+	values := make([]RTValue, len(rTList.Values))
+	copy(values, rTList.Values)
+
+	return values
+}
+
+func (rTList *RTList) length() int {
+	rTList.mutex.RLock()
+	defer rTList.mutex.RUnlock()
+
+	return len(rTList.Values)
+}
+
+func (rTList *RTList) ToString() string {
+	s := "["
+	for _, v := range rTList.values() {
+		s += v.ToString() + " "
+	}
+	s += "]"
+
+	return fmt.Sprintf("(LIST: %s)", s)
+}
+
+func (rTList *RTList) ValueToString() string {
+	return rTList.valueToString(map[RTValue]bool{})
+}
+
+func (rTList *RTList) valueToString(visiting map[RTValue]bool) string {
+	if visiting[rTList] {
+		return "[...]"
+	}
+
+	visiting[rTList] = true
+	defer delete(visiting, rTList)
+
+	elements := rTList.values()
+
+	values := make([]string, 0, len(elements))
+	for _, v := range elements {
+		values = append(values, elementToString(v, visiting))
+	}
+
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+func (rTList *RTList) GetType() RTType {
+	return RTT_LIST
+}
+
+func (rTList *RTList) GetValue() interface{} {
+	return rTList.values()
+}
+
+func (rTList *RTList) GetEnvironment() *Environment {
+	return rTList.Environment
+}
+
+func (rTList *RTList) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "push":
+		return NewRTBuiltinFunction("push", []string{"value"}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			rTList.mutex.Lock()
+			rTList.Values = append(rTList.Values, arguments[0])
+			rTList.mutex.Unlock()
+
+			return NewRTNull(), nil
+		}, position, rTList.Environment), nil
+	case "pop":
+		return NewRTBuiltinFunction("pop", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			rTList.mutex.Lock()
+			defer rTList.mutex.Unlock()
+
+			if len(rTList.Values) == 0 {
+				return nil, NewIndexOutOfRangeRTError(rTList, -1, 0, position, rTList.Environment)
+			}
+
+			value := rTList.Values[len(rTList.Values)-1]
+			rTList.Values = rTList.Values[:len(rTList.Values)-1]
+
+			return value, nil
+		}, position, rTList.Environment), nil
+	case "len":
+		return NewRTBuiltinFunction("len", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			return NewRTInt(position, rTList.length(), rTList.Environment), nil
+		}, position, rTList.Environment), nil
+	case "insert":
+		return NewRTBuiltinFunction("insert", []string{"index", "value"}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			if arguments[0].GetType() != RTT_INT {
+				return nil, NewInvalidIndexRTError(rTList, arguments[0], position, rTList.Environment)
+			}
+
+			index, err := toInt(arguments[0], position, rTList.Environment)
+			if err != nil {
+				return nil, err
+			}
+
+			rTList.mutex.Lock()
+			defer rTList.mutex.Unlock()
+
+			if index < 0 {
+				index += len(rTList.Values)
+			}
+
+			if index < 0 {
+				index = 0
+			} else if index > len(rTList.Values) {
+				index = len(rTList.Values)
+			}
+
+			rTList.Values = append(rTList.Values, nil)
+			copy(rTList.Values[index+1:], rTList.Values[index:])
+			rTList.Values[index] = arguments[1]
+
+			return NewRTNull(), nil
+		}, position, rTList.Environment), nil
+	case "remove":
+		return NewRTBuiltinFunction("remove", []string{"value"}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			for _, v := range rTList.values() {
+				equal, err := v.Equals(arguments[0], position)
+				if err != nil {
+					return nil, err
+				}
+
+				if equal.GetValue() == true && rTList.delete(v) {
+					return NewRTNull(), nil
+				}
+			}
+
+			return nil, NewRuntimeError(
+				VALUE_ERROR,
+				fmt.Sprintf("the list does not contain '%s' with value of '%s'", arguments[0].GetType(), arguments[0].ValueToString()),
+				"",
+				position,
+				rTList.Environment,
+			)
+		}, position, rTList.Environment), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTList, other, position, rTList.Environment)
+}
+
+func (rTList *RTList) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTList, other, value, position, rTList.Environment)
+}
+
+func (rTList *RTList) Index(index RTValue, position SEPos) (RTValue, error) {
+	if index.GetType() != RTT_INT {
+		return nil, NewInvalidIndexRTError(rTList, index, position, rTList.Environment)
+	}
+
+	idx, err := toInt(index, position, rTList.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	rTList.mutex.RLock()
+	defer rTList.mutex.RUnlock()
+
+	i, ok := normalizeIndex(idx, len(rTList.Values))
+	if !ok {
+		return nil, NewIndexOutOfRangeRTError(rTList, idx, len(rTList.Values), position, rTList.Environment)
+	}
+
+	return rTList.Values[i], nil
+}
+
+func (rTList *RTList) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	if index.GetType() != RTT_INT {
+		return nil, NewInvalidIndexRTError(rTList, index, position, rTList.Environment)
+	}
+
+	idx, err := toInt(index, position, rTList.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	rTList.mutex.Lock()
+	defer rTList.mutex.Unlock()
+
+	i, ok := normalizeIndex(idx, len(rTList.Values))
+	if !ok {
+		return nil, NewIndexOutOfRangeRTError(rTList, idx, len(rTList.Values), position, rTList.Environment)
+	}
+
+	rTList.Values[i] = value
+
+	return value, nil
+}
+
+func (rTList *RTList) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	values := rTList.values()
+
+	startIdx, endIdx, err := sliceBounds(rTList, start, end, len(values), position)
+	if err != nil {
+		return nil, err
+	}
+
+	values = values[startIdx:endIdx]
+
+	return NewRTList(position, values, rTList.Environment), nil
+}
+
+func (rTList *RTList) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTList, position, rTList.Environment)
+}
+
+func (rTList *RTList) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	index := 0
+
+	return func() (RTValue, bool, error) {
+		rTList.mutex.RLock()
+		defer rTList.mutex.RUnlock()
+
+		if index >= len(rTList.Values) {
+			return nil, false, nil
+		}
+
+		value := rTList.Values[index]
+		index++
+
+		return value, true, nil
+	}, nil
+}
+
+func (rTList *RTList) Add(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_LIST:
+		values := append(rTList.values(), other.GetValue().([]RTValue)...)
+
+		return NewRTList(position, values, rTList.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PLUS,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Equals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTList.equals(other, position, map[containerPair]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTBool(position, equal, rTList.Environment), nil
+}
+
+func (rTList *RTList) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTList.equals(other, position, map[containerPair]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTBool(position, !equal, rTList.Environment), nil
+}
+
+func (rTList *RTList) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTList,
+		other,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTList.length() == 0, rTList.Environment), nil
+}
+
+func (rTList *RTList) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTList,
+		nil,
+		position,
+		rTList.Environment,
+	)
+}
+
+func (rTList *RTList) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTList.length() != 0, rTList.Environment), nil
+}
+
+func (rTList *RTList) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTList, position, rTList.Environment)
+}
+
+func (rTList *RTList) equals(other RTValue, position SEPos, visiting map[containerPair]bool) (bool, error) {
+	if other.GetType() != RTT_LIST {
+		return false, nil
+	}
+
+	if other == RTValue(rTList) {
+		return true, nil
+	}
+
+	pair := containerPair{rTList, other}
+	if visiting[pair] {
+		return true, nil
+	}
+
+	visiting[pair] = true
+	defer delete(visiting, pair)
+
+	return valuesEqual(rTList.values(), other.GetValue().([]RTValue), position, visiting)
+}
+
+func (rTList *RTList) delete(value RTValue) bool {
+	rTList.mutex.Lock()
+	defer rTList.mutex.Unlock()
+
+	for index, v := range rTList.Values {
+		if v == value {
+			rTList.Values = append(rTList.Values[:index], rTList.Values[index+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+type containerPair struct {
+	x RTValue
+	y RTValue
+}
+
+func elementToString(value RTValue, visiting map[RTValue]bool) string {
+	switch value := value.(type) {
+	case *RTString:
+		return fmt.Sprintf("%q", value.Value)
+	case *RTList:
+		return value.valueToString(visiting)
+	case *RTMap:
+		return value.valueToString(visiting)
+	case *RTTuple:
+		return value.valueToString(visiting)
+	}
+
+	return value.ValueToString()
+}
+
+func elementEquals(x RTValue, y RTValue, position SEPos, visiting map[containerPair]bool) (bool, error) {
+	switch x := x.(type) {
+	case *RTList:
+		return x.equals(y, position, visiting)
+	case *RTMap:
+		return x.equals(y, position, visiting)
+	case *RTTuple:
+		return x.equals(y, position, visiting)
+	}
+
+	equal, err := x.Equals(y, position)
+	if err != nil {
+		return false, err
+	}
+
+	return equal.GetValue() == true, nil
+}
+
+func valuesEqual(x []RTValue, y []RTValue, position SEPos, visiting map[containerPair]bool) (bool, error) {
+	if len(x) != len(y) {
+		return false, nil
+	}
+
+	for index := range x {
+		equal, err := elementEquals(x[index], y[index], position, visiting)
+		if err != nil {
+			return false, err
+		}
+
+		if !equal {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func normalizeIndex(index int, length int) (int, bool) {
+	if index < 0 {
+		index += length
+	}
+
+	if index < 0 || index >= length {
+		return 0, false
+	}
+
+	return index, true
+}
+
+func sliceBounds(x RTValue, start RTValue, end RTValue, length int, position SEPos) (int, int, error) {
+	bounds := []int{0, length}
+
+	for i, bound := range []RTValue{start, end} {
+		if bound.GetType() == RTT_NULL {
+			continue
+		} else if bound.GetType() != RTT_INT {
+			return 0, 0, NewInvalidIndexRTError(x, bound, position, x.GetEnvironment())
+		}
+
+		value, err := toInt(bound, position, x.GetEnvironment())
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if value < 0 {
+			value += length
+		}
+
+		if value < 0 {
+			value = 0
+		} else if value > length {
+			value = length
+		}
+
+		bounds[i] = value
+	}
+
+	if bounds[1] < bounds[0] {
+		bounds[1] = bounds[0]
+	}
+
+	return bounds[0], bounds[1], nil
+}
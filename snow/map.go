@@ -0,0 +1,517 @@
+package snow
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type mapEntry struct {
+	Key   RTValue
+	Value RTValue
+}
+
+type RTMap struct {
+	Pos         SEPos
+	keys        []string
+	entries     map[string]mapEntry
+	Environment *Environment
+	mutex       sync.RWMutex
+}
+
+func NewRTMap(pos SEPos, env *Environment) *RTMap {
+	return &RTMap{
+		Pos:         pos,
+		keys:        make([]string, 0),
+		entries:     make(map[string]mapEntry, 0),
+		Environment: env,
+	}
+}
+
+func (rTMap *RTMap) Get(key RTValue, position SEPos) (RTValue, bool, error) {
+	hash, err := key.Hash(position)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rTMap.mutex.RLock()
+	defer rTMap.mutex.RUnlock()
+
+	entry, ok := rTMap.entries[hash]
+	if !ok {
+		return nil, false, nil
+	}
+
+	return entry.Value, true, nil
+}
+
+func (rTMap *RTMap) Set(key RTValue, value RTValue, position SEPos) error {
+	hash, err := key.Hash(position)
+	if err != nil {
+		return err
+	}
+
+	rTMap.mutex.Lock()
+	defer rTMap.mutex.Unlock()
+
+	if entry, ok := rTMap.entries[hash]; ok {
+		rTMap.entries[hash] = mapEntry{
+			Key:   entry.Key,
+			Value: value,
+		}
+
+		return nil
+	}
+
+	rTMap.keys = append(rTMap.keys, hash)
+	rTMap.entries[hash] = mapEntry{
+		Key:   key,
+		Value: value,
+	}
+
+	return nil
+}
+
+func (rTMap *RTMap) Delete(key RTValue, position SEPos) (bool, error) {
+	hash, err := key.Hash(position)
+	if err != nil {
+		return false, err
+	}
+
+	rTMap.mutex.Lock()
+	defer rTMap.mutex.Unlock()
+
+	if _, ok := rTMap.entries[hash]; !ok {
+		return false, nil
+	}
+
+	delete(rTMap.entries, hash)
+
+	for index, k := range rTMap.keys {
+		if k == hash {
+			rTMap.keys = append(rTMap.keys[:index], rTMap.keys[index+1:]...)
+			break
+		}
+	}
+
+	return true, nil
+}
+
+func (rTMap *RTMap) Entries() []mapEntry {
+	rTMap.mutex.RLock()
+	defer rTMap.mutex.RUnlock()
+
+	entries := make([]mapEntry, 0, len(rTMap.keys))
+	for _, hash := range rTMap.keys {
+		entries = append(entries, rTMap.entries[hash])
+	}
+
+	return entries
+}
+
+func (rTMap *RTMap) length() int {
+	rTMap.mutex.RLock()
+	defer rTMap.mutex.RUnlock()
+
+	return len(rTMap.keys)
+}
+
+func (rTMap *RTMap) ToString() string {
+	s := "{"
+	for _, entry := range rTMap.Entries() {
+		s += entry.Key.ToString() + ": " + entry.Value.ToString() + " "
+	}
+	s += "}"
+
+	return fmt.Sprintf("(MAP: %s)", s)
+}
+
+func (rTMap *RTMap) ValueToString() string {
+	return rTMap.valueToString(map[RTValue]bool{})
+}
+
+func (rTMap *RTMap) valueToString(visiting map[RTValue]bool) string {
+	if visiting[rTMap] {
+		return "{...}"
+	}
+
+	visiting[rTMap] = true
+	defer delete(visiting, rTMap)
+
+	mapEntries := rTMap.Entries()
+
+	entries := make([]string, 0, len(mapEntries))
+	for _, entry := range mapEntries {
+		entries = append(entries, elementToString(entry.Key, visiting)+": "+elementToString(entry.Value, visiting))
+	}
+
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+func (rTMap *RTMap) GetType() RTType {
+	return RTT_MAP
+}
+
+func (rTMap *RTMap) GetValue() interface{} {
+	return rTMap.Entries()
+}
+
+func (rTMap *RTMap) GetEnvironment() *Environment {
+	return rTMap.Environment
+}
+
+func (rTMap *RTMap) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "keys":
+		return NewRTBuiltinFunction("keys", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			keys := make([]RTValue, 0, rTMap.length())
+			for _, entry := range rTMap.Entries() {
+				keys = append(keys, entry.Key)
+			}
+
+			return NewRTList(position, keys, rTMap.Environment), nil
+		}, position, rTMap.Environment), nil
+	case "values":
+		return NewRTBuiltinFunction("values", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			values := make([]RTValue, 0, rTMap.length())
+			for _, entry := range rTMap.Entries() {
+				values = append(values, entry.Value)
+			}
+
+			return NewRTList(position, values, rTMap.Environment), nil
+		}, position, rTMap.Environment), nil
+	case "items":
+		return NewRTBuiltinFunction("items", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			items := make([]RTValue, 0, rTMap.length())
+			for _, entry := range rTMap.Entries() {
+				items = append(items, NewRTList(position, []RTValue{entry.Key, entry.Value}, rTMap.Environment))
+			}
+
+			return NewRTList(position, items, rTMap.Environment), nil
+		}, position, rTMap.Environment), nil
+	case "has":
+		return NewRTBuiltinFunction("has", []string{"key"}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			_, ok, err := rTMap.Get(arguments[0], position)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewRTBool(position, ok, rTMap.Environment), nil
+		}, position, rTMap.Environment), nil
+	case "delete":
+		return NewRTBuiltinFunction("delete", []string{"key"}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			ok, err := rTMap.Delete(arguments[0], position)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				return nil, NewKeyRTError(rTMap, arguments[0], position, rTMap.Environment)
+			}
+
+			return NewRTNull(), nil
+		}, position, rTMap.Environment), nil
+	case "len":
+		return NewRTBuiltinFunction("len", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			return NewRTInt(position, rTMap.length(), rTMap.Environment), nil
+		}, position, rTMap.Environment), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTMap, other, position, rTMap.Environment)
+}
+
+func (rTMap *RTMap) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTMap, other, value, position, rTMap.Environment)
+}
+
+func (rTMap *RTMap) Index(index RTValue, position SEPos) (RTValue, error) {
+	value, ok, err := rTMap.Get(index, position)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, NewKeyRTError(rTMap, index, position, rTMap.Environment)
+	}
+
+	return value, nil
+}
+
+func (rTMap *RTMap) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	err := rTMap.Set(index, value, position)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (rTMap *RTMap) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotSliceableRTError(rTMap, position, rTMap.Environment)
+}
+
+func (rTMap *RTMap) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTMap, position, rTMap.Environment)
+}
+
+func (rTMap *RTMap) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	entries := rTMap.Entries()
+	index := 0
+
+	return func() (RTValue, bool, error) {
+		if index >= len(entries) {
+			return nil, false, nil
+		}
+
+		value := entries[index].Key
+		index++
+
+		return value, true, nil
+	}, nil
+}
+
+func (rTMap *RTMap) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Equals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTMap.equals(other, position, map[containerPair]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTBool(position, equal, rTMap.Environment), nil
+}
+
+func (rTMap *RTMap) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTMap.equals(other, position, map[containerPair]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTBool(position, !equal, rTMap.Environment), nil
+}
+
+func (rTMap *RTMap) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTMap,
+		other,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTMap.length() == 0, rTMap.Environment), nil
+}
+
+func (rTMap *RTMap) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTMap,
+		nil,
+		position,
+		rTMap.Environment,
+	)
+}
+
+func (rTMap *RTMap) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTMap.length() != 0, rTMap.Environment), nil
+}
+
+func (rTMap *RTMap) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTMap, position, rTMap.Environment)
+}
+
+func (rTMap *RTMap) equals(other RTValue, position SEPos, visiting map[containerPair]bool) (bool, error) {
+	if other.GetType() != RTT_MAP {
+		return false, nil
+	}
+
+	if other == RTValue(rTMap) {
+		return true, nil
+	}
+
+	pair := containerPair{rTMap, other}
+	if visiting[pair] {
+		return true, nil
+	}
+
+	visiting[pair] = true
+	defer delete(visiting, pair)
+
+	otherMap := other.(*RTMap)
+	entries := rTMap.Entries()
+	if len(entries) != otherMap.length() {
+		return false, nil
+	}
+
+	for _, entry := range entries {
+		otherValue, ok, err := otherMap.Get(entry.Key, position)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+
+		equal, err := elementEquals(entry.Value, otherValue, position, visiting)
+		if err != nil {
+			return false, err
+		}
+
+		if !equal {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,460 @@
+package snow
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type moduleEntry struct {
+	module  *RTModule
+	err     error
+	done    chan struct{}
+	waiting string
+}
+
+type ModuleLoader struct {
+	mutex   sync.Mutex
+	modules map[string]*moduleEntry
+}
+
+func NewModuleLoader() *ModuleLoader {
+	return &ModuleLoader{
+		modules: make(map[string]*moduleEntry, 0),
+	}
+}
+
+var defaultModuleLoader = NewModuleLoader()
+
+func resolveModulePath(path string, importer string) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(importer), path)
+	}
+
+	if filepath.Ext(path) == "" {
+		path += ".snow"
+	}
+
+	return filepath.Clean(path)
+}
+
+func newImportCycleRTError(path string, chain []string, key string, pos SEPos, env *Environment) error {
+	cycle := make([]string, 0)
+	for index, loading := range chain {
+		if loading == key {
+			for _, name := range chain[index:] {
+				cycle = append(cycle, filepath.Base(name))
+			}
+
+			break
+		}
+	}
+
+	if len(cycle) == 0 && len(chain) != 0 {
+		cycle = append(cycle, filepath.Base(chain[len(chain)-1]))
+	}
+	cycle = append(cycle, filepath.Base(key))
+
+	return NewRuntimeError(
+		IMPORT_CYCLE_ERROR,
+		fmt.Sprintf("import cycle found while importing '%s': %s", path, strings.Join(cycle, " -> ")),
+		"Move the shared code into a module that does not import the others",
+		pos,
+		env,
+	)
+}
+
+func containsPath(chain []string, key string) bool {
+	for _, loading := range chain {
+		if loading == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (loader *ModuleLoader) Load(path string, chain []string, pos SEPos, env *Environment) (*RTModule, error) {
+	fileName := resolveModulePath(path, pos.File.Name)
+
+	key, err := filepath.Abs(fileName)
+	if err != nil {
+		key = fileName
+	}
+
+	if len(chain) == 0 {
+		importer, err := filepath.Abs(pos.File.Name)
+		if err != nil {
+			importer = pos.File.Name
+		}
+
+		chain = []string{importer}
+	}
+
+	if containsPath(chain, key) {
+		return nil, newImportCycleRTError(path, chain, key, pos, env)
+	}
+
+	loader.mutex.Lock()
+
+	if entry, ok := loader.modules[key]; ok {
+		select {
+		case <-entry.done:
+			loader.mutex.Unlock()
+
+			return entry.module, entry.err
+		default:
+		}
+
+		for waiting := entry.waiting; waiting != ""; {
+			if containsPath(chain, waiting) {
+				loader.mutex.Unlock()
+
+				return nil, newImportCycleRTError(path, chain, key, pos, env)
+			}
+
+			next, ok := loader.modules[waiting]
+			if !ok {
+				break
+			}
+
+			waiting = next.waiting
+		}
+
+		importer, loadingImporter := loader.modules[chain[len(chain)-1]]
+		if loadingImporter {
+			importer.waiting = key
+		}
+
+		loader.mutex.Unlock()
+
+		<-entry.done
+
+		if loadingImporter {
+			loader.mutex.Lock()
+			importer.waiting = ""
+			loader.mutex.Unlock()
+		}
+
+		return entry.module, entry.err
+	}
+
+	entry := &moduleEntry{done: make(chan struct{})}
+	loader.modules[key] = entry
+
+	loader.mutex.Unlock()
+
+	entry.module, entry.err = loader.load(path, fileName, key, chain, pos, env)
+
+	if entry.err != nil {
+		loader.mutex.Lock()
+		delete(loader.modules, key)
+		loader.mutex.Unlock()
+	}
+
+	close(entry.done)
+
+	return entry.module, entry.err
+}
+
+func (loader *ModuleLoader) load(path string, fileName string, key string, chain []string, pos SEPos, env *Environment) (*RTModule, error) {
+	code, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, NewRuntimeError(
+			IMPORT_ERROR,
+			fmt.Sprintf("unable to import the module '%s' from '%s'", path, fileName),
+			"",
+			pos,
+			env,
+		)
+	}
+
+	file := NewFile(fileName, string(code))
+	file.TabWidth = pos.File.TabWidth
+
+	tokens, errs := NewLexer(file).Tokenize()
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+
+	statements, err := NewParser(tokens, file).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	moduleEnv := NewEnvironment(nil, fileName, 1, fileName, true)
+	moduleEnv.Caller = env
+
+	interpreter := NewInterpreter(statements, file, moduleEnv)
+	interpreter.modules = loader
+	interpreter.importing = append(chain[:len(chain):len(chain)], key)
+
+	_, err = interpreter.Interpret()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTModule(path, fileName, moduleEnv, pos, env), nil
+}
+
+type RTModule struct {
+	Name        string
+	Path        string
+	Members     *Environment
+	Pos         SEPos
+	Environment *Environment
+}
+
+func NewRTModule(name string, path string, members *Environment, pos SEPos, env *Environment) *RTModule {
+	return &RTModule{
+		Name:        name,
+		Path:        path,
+		Members:     members,
+		Pos:         pos,
+		Environment: env,
+	}
+}
+
+func (rTModule *RTModule) ToString() string {
+	return fmt.Sprintf("(MODULE: %s)", rTModule.Name)
+}
+
+func (rTModule *RTModule) ValueToString() string {
+	return fmt.Sprintf("MODULE %s", rTModule.Name)
+}
+
+func (rTModule *RTModule) GetType() RTType {
+	return RTT_MODULE
+}
+
+func (rTModule *RTModule) GetValue() interface{} {
+	return rTModule.Members
+}
+
+func (rTModule *RTModule) GetEnvironment() *Environment {
+	return rTModule.Environment
+}
+
+func (rTModule *RTModule) Dot(other Token, position SEPos) (RTValue, error) {
+	if value, ok := rTModule.Members.Lookup(other.Value); ok {
+		return value, nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTModule, other, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTModule, other, value, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTModule, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTModule, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTModule, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTModule, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTModule, position, rTModule.Environment)
+}
+
+func (rTModule *RTModule) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTModule), rTModule.Environment), nil
+}
+
+func (rTModule *RTModule) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTModule), rTModule.Environment), nil
+}
+
+func (rTModule *RTModule) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTModule,
+		other,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTModule.Environment), nil
+}
+
+func (rTModule *RTModule) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTModule,
+		nil,
+		position,
+		rTModule.Environment,
+	)
+}
+
+func (rTModule *RTModule) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTModule.Environment), nil
+}
+
+func (rTModule *RTModule) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTModule, position, rTModule.Environment)
+}
@@ -0,0 +1,247 @@
+package snow
+
+type RTNull struct{}
+
+var rTNullValue = &RTNull{}
+
+func NewRTNull() *RTNull {
+	return rTNullValue
+}
+
+func (rTNull *RTNull) ToString() string {
+	return "(NULL)"
+}
+
+func (rTNull *RTNull) ValueToString() string {
+	return "null"
+}
+
+func (rTNull *RTNull) GetType() RTType {
+	return RTT_NULL
+}
+
+func (rTNull *RTNull) GetValue() interface{} {
+	return nil
+}
+
+func (rTNull *RTNull) GetEnvironment() *Environment {
+	return nil
+}
+
+func (rTNull *RTNull) Dot(other Token, position SEPos) (RTValue, error) {
+	return nil, NewInvalidAttributeRTError(rTNull, other, position, nil)
+}
+
+func (rTNull *RTNull) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTNull, other, value, position, nil)
+}
+
+func (rTNull *RTNull) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTNull, position, nil)
+}
+
+func (rTNull *RTNull) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTNull, position, nil)
+}
+
+func (rTNull *RTNull) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTNull, position, nil)
+}
+
+func (rTNull *RTNull) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTNull, position, nil)
+}
+
+func (rTNull *RTNull) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTNull, position, nil)
+}
+
+func (rTNull *RTNull) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other.GetType() == RTT_NULL, nil), nil
+}
+
+func (rTNull *RTNull) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other.GetType() != RTT_NULL, nil), nil
+}
+
+func (rTNull *RTNull) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTNull,
+		other,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, nil), nil
+}
+
+func (rTNull *RTNull) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTNull,
+		nil,
+		position,
+		nil,
+	)
+}
+
+func (rTNull *RTNull) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, nil), nil
+}
+
+func (rTNull *RTNull) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTNull, position, nil)
+}
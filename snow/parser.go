@@ -2,16 +2,25 @@ package snow
 
 import (
 	"fmt"
+	"math/big"
+	"path/filepath"
 	"strconv"
+	"strings"
 )
 
 type Parser struct {
-	tokens       []Token
-	file         *File
-	currentToken Token
-	index        int
-	inBlock      int
-	inLoop       int
+	tokens        []Token
+	file          *File
+	currentToken  Token
+	index         int
+	inBlock       int
+	inLoop        int
+	inGuard       bool
+	inDestructure bool
+	classes       []bool
+	generators    []bool
+	labels        []string
+	warnings      []SnowError
 }
 
 func NewParser(tokens []Token, file *File) *Parser {
@@ -22,6 +31,10 @@ func NewParser(tokens []Token, file *File) *Parser {
 	}
 }
 
+func (parser *Parser) Warnings() []SnowError {
+	return parser.warnings
+}
+
 func (parser *Parser) advance() {
 	if parser.currentToken.TType != EOF {
 		parser.index++
@@ -37,6 +50,12 @@ func (parser *Parser) peek() Token {
 	return parser.currentToken
 }
 
+func (parser *Parser) skipNewlines() {
+	for parser.currentToken.TType == NEWLINE {
+		parser.advance()
+	}
+}
+
 func (parser *Parser) consume(tType TokenType) error {
 	if parser.currentToken.TType != tType {
 		pos := parser.currentToken.Pos
@@ -120,13 +139,24 @@ func (parser *Parser) deceleration() (Stmt, error) {
 		}
 
 		return varDeclStmt, nil
-	} else if parser.currentToken.TType == FUNCTION {
+	} else if parser.currentToken.TType == FUNCTION && parser.peek().TType == IDENTIFIER {
 		function, err := parser.functionDeclStmt()
 		if err != nil {
 			return nil, err
 		}
 
 		return function, nil
+	} else if parser.currentToken.TType == IMPORT {
+		return parser.importStmt()
+	} else if parser.currentToken.TType == FROM {
+		return parser.fromImportStmt()
+	} else if parser.currentToken.TType == CLASS {
+		class, err := parser.classDeclStmt()
+		if err != nil {
+			return nil, err
+		}
+
+		return class, nil
 	}
 
 	statement, err := parser.statement()
@@ -137,579 +167,2592 @@ func (parser *Parser) deceleration() (Stmt, error) {
 	return statement, nil
 }
 
-func (parser *Parser) functionDeclStmt() (Stmt, error) {
+func (parser *Parser) importStmt() (Stmt, error) {
 	startPos := parser.currentToken.Pos.Start
 
-	err := parser.consume(FUNCTION)
-	if err != nil {
-		return nil, err
-	}
-
-	name := parser.currentToken
-	err = parser.consume(IDENTIFIER)
+	err := parser.consume(IMPORT)
 	if err != nil {
 		return nil, err
 	}
 
-	err = parser.consume(LPAREN)
+	path := parser.currentToken
+	err = parser.consume(STRING)
 	if err != nil {
 		return nil, err
 	}
 
-	parameters := make([]Token, 0)
+	name := strings.TrimSuffix(filepath.Base(path.Value), filepath.Ext(path.Value))
+	alias := *NewToken(IDENTIFIER, name, path.Pos)
 
-	for parser.currentToken.TType != EOF && parser.currentToken.TType != RPAREN {
-		parameter := parser.currentToken
+	if parser.currentToken.TType == AS {
+		parser.advance()
 
+		alias = parser.currentToken
 		err = parser.consume(IDENTIFIER)
 		if err != nil {
 			return nil, err
 		}
-
-		if parser.currentToken.TType != RPAREN {
-			err = parser.consume(COMMA)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		parameters = append(parameters, parameter)
-	}
-
-	err = parser.consume(RPAREN)
-	if err != nil {
-		return nil, err
 	}
 
-	block, err := parser.blockStatement()
+	err = parser.endStatement()
 	if err != nil {
 		return nil, err
 	}
 
-	return NewFunctionDeclStmt(name.Value, parameters, block.(*BlockStmt), *startPos.CreateSEPos(block.GetPos().End, block.GetPos().File)), nil
+	return NewImportStmt(path, alias, nil, *startPos.CreateSEPos(alias.Pos.End, path.Pos.File)), nil
 }
 
-func (parser *Parser) varDeclStmt() (Stmt, error) {
-	startTok := parser.currentToken
-
-	parser.advance()
+func (parser *Parser) fromImportStmt() (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
 
-	if parser.currentToken.TType != IDENTIFIER {
-		return nil, NewUnexpectedTokenError(IDENTIFIER, parser.currentToken)
+	err := parser.consume(FROM)
+	if err != nil {
+		return nil, err
 	}
 
-	identifier := parser.currentToken
-
-	parser.advance()
-
-	err := parser.consume(SINGLE_EQUALS)
+	path := parser.currentToken
+	err = parser.consume(STRING)
 	if err != nil {
 		return nil, err
 	}
 
-	expr, err := parser.expression()
+	err = parser.consume(IMPORT)
 	if err != nil {
 		return nil, err
 	}
 
-	if parser.currentToken.TType != EOF {
-		err = parser.consume(NEWLINE)
+	names := make([]Token, 0)
+	for {
+		name := parser.currentToken
+		err = parser.consume(IDENTIFIER)
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	return NewVarDeclStmt(startTok, identifier, expr, *startTok.Pos.Start.CreateSEPos(expr.GetPosition().End, startTok.Pos.File)), nil
-}
+		names = append(names, name)
 
-func (parser *Parser) statement() (Stmt, error) {
-	if parser.currentToken.TType == LCURLYBRACKET {
-		return parser.blockStatement()
-	} else if parser.currentToken.TType == WHILE {
-		return parser.whileStatement()
-	} else if parser.currentToken.TType == BREAK {
-		return parser.breakStmt()
-	} else if parser.currentToken.TType == CONTINUE {
-		return parser.continueStmt()
-	} else if parser.currentToken.TType == RETURN {
-		return parser.returnStmt()
-	} else if parser.currentToken.TType == IF {
-		return parser.ifStatement()
+		if parser.currentToken.TType != COMMA {
+			break
+		}
+
+		parser.advance()
 	}
 
-	statement, err := parser.expressionStmt()
+	endPos := names[len(names)-1].Pos.End
+
+	err = parser.endStatement()
 	if err != nil {
 		return nil, err
 	}
 
-	return statement, nil
+	return NewImportStmt(path, Token{}, names, *startPos.CreateSEPos(endPos, path.Pos.File)), nil
 }
 
-func (parser *Parser) ifStatement() (Stmt, error) {
+func (parser *Parser) endStatement() error {
+	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		return parser.consume(NEWLINE)
+	}
+
+	return nil
+}
+
+func (parser *Parser) functionDeclStmt() (Stmt, error) {
 	startPos := parser.currentToken.Pos.Start
 
-	err := parser.consume(IF)
+	err := parser.consume(FUNCTION)
 	if err != nil {
 		return nil, err
 	}
 
-	ifExpr, err := parser.expression()
+	name := parser.currentToken
+	err = parser.consume(IDENTIFIER)
 	if err != nil {
 		return nil, err
 	}
 
-	ifStmt, err := parser.statement()
+	parameters, err := parser.parameters()
 	if err != nil {
 		return nil, err
 	}
 
-	firstIf := NewIfStmt(ifExpr, ifStmt, *startPos.CreateSEPos(ifStmt.GetPos().End, ifStmt.GetPos().File))
+	returnType, err := parser.optionalTypeAnnotation()
+	if err != nil {
+		return nil, err
+	}
 
-	stmts := make([]IfStmt, 0)
-	stmts = append(stmts, *firstIf)
+	block, generator, err := parser.functionBody()
+	if err != nil {
+		return nil, err
+	}
 
-	for parser.currentToken.TType == ELIF {
-		elifStartPos := parser.currentToken.Pos.Start
+	return NewFunctionDeclStmt(name.Value, parameters, returnType, block, generator, *startPos.CreateSEPos(block.Pos.End, block.Pos.File)), nil
+}
+
+func (parser *Parser) optionalTypeAnnotation() (*TypeAnnotation, error) {
+	if parser.currentToken.TType != COLON {
+		return nil, nil
+	}
+
+	parser.advance()
+
+	return parser.typeAnnotation()
+}
+
+func (parser *Parser) typeAnnotation() (*TypeAnnotation, error) {
+	startPos := parser.currentToken.Pos.Start
+	endPos := parser.currentToken.Pos.End
+
+	names := make([]Token, 0)
+	optional := false
+
+	for {
+		name := parser.currentToken
+		if name.TType != IDENTIFIER && name.TType != NULL && name.TType != FUNCTION {
+			return nil, NewUnexpectedTokenError(IDENTIFIER, name)
+		}
+
+		names = append(names, name)
+		endPos = name.Pos.End
 
 		parser.advance()
 
-		elifExpr, err := parser.expression()
-		if err != nil {
-			return nil, err
+		if parser.currentToken.TType == QUESTION {
+			optional = true
+			endPos = parser.currentToken.Pos.End
+
+			parser.advance()
 		}
 
-		elifStmt, err := parser.statement()
-		if err != nil {
-			return nil, err
+		if parser.currentToken.TType != PIPE {
+			break
 		}
 
-		stmts = append(stmts, *NewIfStmt(
-			elifExpr,
-			elifStmt,
-			*elifStartPos.CreateSEPos(elifStmt.GetPos().End, elifExpr.GetPosition().File),
-		))
+		parser.advance()
 	}
 
-	if parser.currentToken.TType == ELSE {
-		elseStartPos := parser.currentToken.Pos.Start
+	return NewTypeAnnotation(names, optional, *startPos.CreateSEPos(endPos, parser.file)), nil
+}
 
-		parser.advance()
+func (parser *Parser) parameters() ([]Parameter, error) {
+	err := parser.consume(LPAREN)
+	if err != nil {
+		return nil, err
+	}
 
-		elseStmt, err := parser.statement()
+	parameters := make([]Parameter, 0)
+	hasDefault := false
+
+	for parser.currentToken.TType != EOF && parser.currentToken.TType != RPAREN {
+		if len(parameters) > 0 && parameters[len(parameters)-1].Variadic {
+			return nil, NewSnowError(
+				INVALID_PARAMETER_ERROR,
+				"a variadic parameter has to be the last parameter",
+				"",
+				parser.currentToken.Pos,
+			)
+		}
+
+		variadic := false
+		if parser.currentToken.TType == ELLIPSIS {
+			variadic = true
+
+			parser.advance()
+		}
+
+		name := parser.currentToken
+
+		err = parser.consume(IDENTIFIER)
 		if err != nil {
 			return nil, err
 		}
 
-		stmts = append(stmts, *NewIfStmt(
-			nil,
-			elseStmt,
-			*elseStartPos.CreateSEPos(elseStmt.GetPos().End, elseStmt.GetPos().File),
-		))
-	}
-
-	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
-		err := parser.consume(NEWLINE)
+		typeAnnotation, err := parser.optionalTypeAnnotation()
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	return NewIfStmtContainer(stmts, *startPos.CreateSEPos(stmts[len(stmts)-1].Pos.End, firstIf.Pos.File)), nil
-}
+		var def Expr
+		if !variadic && parser.currentToken.TType == SINGLE_EQUALS {
+			parser.advance()
 
-func (parser *Parser) whileStatement() (Stmt, error) {
-	startPos := parser.currentToken.Pos.Start
+			def, err = parser.expression()
+			if err != nil {
+				return nil, err
+			}
 
-	err := parser.consume(WHILE)
-	if err != nil {
-		return nil, err
+			hasDefault = true
+		} else if !variadic && hasDefault {
+			return nil, NewSnowError(
+				INVALID_PARAMETER_ERROR,
+				fmt.Sprintf("the parameter '%s' has no default value but follows a parameter with a default value", name.Value),
+				"Parameters without default values have to come before parameters with default values",
+				name.Pos,
+			)
+		}
+
+		if parser.currentToken.TType != RPAREN {
+			err = parser.consume(COMMA)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		parameters = append(parameters, *NewParameter(name, typeAnnotation, def, variadic))
 	}
 
-	expr, err := parser.expression()
+	err = parser.consume(RPAREN)
 	if err != nil {
 		return nil, err
 	}
 
-	parser.inLoop += 1
+	return parameters, nil
+}
 
-	stmt, err := parser.statement()
+func (parser *Parser) functionBody() (*BlockStmt, bool, error) {
+	inLoop, labels := parser.inLoop, parser.labels
+	parser.inLoop, parser.labels = 0, nil
+
+	parser.generators = append(parser.generators, false)
+
+	block, err := parser.blockStatement()
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	parser.inLoop -= 1
+	generator := parser.generators[len(parser.generators)-1]
+	parser.generators = parser.generators[:len(parser.generators)-1]
+
+	parser.inLoop, parser.labels = inLoop, labels
 
-	return NewWhileStmt(stmt, expr, *startPos.CreateSEPos(stmt.GetPos().End, stmt.GetPos().File)), nil
+	return block.(*BlockStmt), generator, nil
 }
 
-func (parser *Parser) blockStatement(params ...string) (Stmt, error) {
+func (parser *Parser) functionExpr() (Expr, error) {
 	startPos := parser.currentToken.Pos.Start
-	file := parser.currentToken.Pos.File
-	statements := make([]Stmt, 0)
 
-	err := parser.consume(LCURLYBRACKET)
+	err := parser.consume(FUNCTION)
 	if err != nil {
 		return nil, err
 	}
 
-	for parser.currentToken.TType == NEWLINE {
-		parser.advance()
+	parameters, err := parser.parameters()
+	if err != nil {
+		return nil, err
 	}
 
-	parser.inBlock += 1
+	returnType, err := parser.optionalTypeAnnotation()
+	if err != nil {
+		return nil, err
+	}
 
-	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
-		if parser.currentToken.TType == NEWLINE {
-			parser.advance()
+	block, generator, err := parser.functionBody()
+	if err != nil {
+		return nil, err
+	}
 
-			continue
-		}
+	return NewFunctionExpr(parameters, returnType, block, generator, *startPos.CreateSEPos(block.Pos.End, block.Pos.File)), nil
+}
 
-		statement, err := parser.deceleration()
-		if err != nil {
-			return nil, err
-		}
+func (parser *Parser) isArrowFunction() bool {
+	if parser.inGuard {
+		return false
+	}
 
-		statements = append(statements, statement)
+	if parser.currentToken.TType == IDENTIFIER {
+		return parser.peek().TType == ARROW
 	}
 
-	parser.inBlock -= 1
+	depth := 0
+	for index := parser.index; index < len(parser.tokens); index++ {
+		switch parser.tokens[index].TType {
+		case LPAREN:
+			depth++
+		case RPAREN:
+			depth--
 
-	if parser.currentToken.TType != RCURLYBRACKET {
-		return nil, NewUnexpectedTokenError(RCURLYBRACKET, parser.currentToken)
+			if depth == 0 {
+				return index+1 < len(parser.tokens) && parser.tokens[index+1].TType == ARROW
+			}
+		case EOF:
+			return false
+		}
 	}
 
-	endPos := parser.currentToken.Pos
+	return false
+}
 
-	parser.advance()
+func (parser *Parser) arrowFunction() (Expr, error) {
+	startPos := parser.currentToken.Pos.Start
 
-	name := ""
-	if len(params) != 0 {
-		name = params[0]
-	}
+	var parameters []Parameter
+	if parser.currentToken.TType == IDENTIFIER {
+		parameters = []Parameter{*NewParameter(parser.currentToken, nil, nil, false)}
 
-	return NewBlockStmt(statements, name, *startPos.CreateSEPos(endPos.End, file)), nil
-}
+		parser.advance()
+	} else {
+		var err error
 
-func (parser *Parser) expressionStmt() (Stmt, error) {
-	pos := parser.currentToken.Pos
+		parameters, err = parser.parameters()
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	expression, err := parser.expression()
+	err := parser.consume(ARROW)
 	if err != nil {
 		return nil, err
 	}
 
-	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
-		err = parser.consume(NEWLINE)
+	if parser.currentToken.TType == LCURLYBRACKET {
+		block, generator, err := parser.functionBody()
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	return NewExpressionStmt(expression, pos), nil
-}
+		return NewFunctionExpr(parameters, nil, block, generator, *startPos.CreateSEPos(block.Pos.End, block.Pos.File)), nil
+	}
 
-func (parser *Parser) returnStmt() (Stmt, error) {
-	pos := parser.currentToken.Pos
+	inLoop := parser.inLoop
+	parser.inLoop = 0
 
-	err := parser.consume(RETURN)
+	body, err := parser.expression()
 	if err != nil {
 		return nil, err
 	}
 
-	if parser.inBlock == 0 {
-		return nil, NewSnowError(
-			RETURN_OUTSIDE_OF_LOOP_ERROR,
-			"return statement found outside of block",
-			"Return statements can only be used inside of blocks",
-			pos,
+	parser.inLoop = inLoop
+
+	pos := *startPos.CreateSEPos(body.GetPosition().End, body.GetPosition().File)
+	block := NewBlockStmt([]Stmt{NewReturnStmt(body, body.GetPosition())}, "", pos)
+
+	return NewFunctionExpr(parameters, nil, block, false, pos), nil
+}
+
+func (parser *Parser) classDeclStmt() (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(CLASS)
+	if err != nil {
+		return nil, err
+	}
+
+	name := parser.currentToken
+	err = parser.consume(IDENTIFIER)
+	if err != nil {
+		return nil, err
+	}
+
+	var superClass *VarAccessExpr
+	if parser.currentToken.TType == LPAREN {
+		parser.advance()
+
+		superClassTok := parser.currentToken
+		err = parser.consume(IDENTIFIER)
+		if err != nil {
+			return nil, err
+		}
+
+		superClass = NewVarAccessExpr(superClassTok.Value, superClassTok.Pos)
+
+		err = parser.consume(RPAREN)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = parser.consume(LCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.classes = append(parser.classes, superClass != nil)
+
+	methods := make([]*FunctionDeclStmt, 0)
+
+	parser.skipNewlines()
+
+	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		if parser.currentToken.TType != FUNCTION {
+			return nil, NewUnexpectedTokenError(FUNCTION, parser.currentToken)
+		}
+
+		method, err := parser.functionDeclStmt()
+		if err != nil {
+			return nil, err
+		}
+
+		methods = append(methods, method.(*FunctionDeclStmt))
+
+		parser.skipNewlines()
+	}
+
+	parser.classes = parser.classes[:len(parser.classes)-1]
+
+	endPos := parser.currentToken.Pos.End
+
+	err = parser.consume(RCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewClassDeclStmt(name.Value, superClass, methods, *startPos.CreateSEPos(endPos, parser.file)), nil
+}
+
+func (parser *Parser) varDeclStmt() (Stmt, error) {
+	startTok := parser.currentToken
+
+	parser.advance()
+
+	if parser.currentToken.TType == LBRACKET || parser.currentToken.TType == LCURLYBRACKET || parser.currentToken.TType == LPAREN || (parser.currentToken.TType == IDENTIFIER && (parser.peek().TType == LPAREN || parser.peek().TType == COMMA)) {
+		return parser.destructuringVarDeclStmt(startTok)
+	}
+
+	if parser.currentToken.TType != IDENTIFIER {
+		return nil, NewUnexpectedTokenError(IDENTIFIER, parser.currentToken)
+	}
+
+	identifier := parser.currentToken
+
+	parser.advance()
+
+	typeAnnotation, err := parser.optionalTypeAnnotation()
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(SINGLE_EQUALS)
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewVarDeclStmt(startTok, identifier, typeAnnotation, expr, *startTok.Pos.Start.CreateSEPos(expr.GetPosition().End, startTok.Pos.File)), nil
+}
+
+func (parser *Parser) destructuringVarDeclStmt(startTok Token) (Stmt, error) {
+	parser.inDestructure = true
+
+	pattern, err := parser.destructuringPattern()
+
+	parser.inDestructure = false
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.checkBindings(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(SINGLE_EQUALS)
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.endStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDestructuringVarDeclStmt(startTok, pattern, expr, *startTok.Pos.Start.CreateSEPos(expr.GetPosition().End, startTok.Pos.File)), nil
+}
+
+func (parser *Parser) destructuringPattern() (Pattern, error) {
+	pattern, err := parser.simplePattern()
+	if err != nil || parser.currentToken.TType != COMMA {
+		return pattern, err
+	}
+
+	elements := []Pattern{pattern}
+	for parser.currentToken.TType == COMMA {
+		parser.advance()
+
+		pattern, err = parser.simplePattern()
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, pattern)
+	}
+
+	return NewTuplePattern(elements, *elements[0].GetPosition().Start.CreateSEPos(pattern.GetPosition().End, pattern.GetPosition().File)), nil
+}
+
+func (parser *Parser) statement() (Stmt, error) {
+	if parser.currentToken.TType == LCURLYBRACKET && !parser.isMapLiteral() {
+		return parser.blockStatement()
+	} else if parser.currentToken.TType == WHILE {
+		return parser.whileStatement(nil)
+	} else if parser.currentToken.TType == FOR {
+		return parser.forStatement(nil)
+	} else if parser.currentToken.TType == IDENTIFIER && parser.peek().TType == COLON {
+		return parser.labeledStatement()
+	} else if parser.currentToken.TType == BREAK {
+		return parser.breakStmt()
+	} else if parser.currentToken.TType == CONTINUE {
+		return parser.continueStmt()
+	} else if parser.currentToken.TType == RETURN {
+		return parser.returnStmt()
+	} else if parser.currentToken.TType == YIELD {
+		return parser.yieldStmt()
+	} else if parser.currentToken.TType == IF {
+		return parser.ifStatement()
+	} else if parser.currentToken.TType == MATCH {
+		return parser.matchStatement()
+	} else if parser.currentToken.TType == SELECT {
+		return parser.selectStatement()
+	} else if parser.currentToken.TType == TRY {
+		return parser.tryStatement()
+	} else if parser.currentToken.TType == THROW {
+		return parser.throwStmt()
+	}
+
+	statement, err := parser.expressionStmt()
+	if err != nil {
+		return nil, err
+	}
+
+	return statement, nil
+}
+
+func (parser *Parser) ifStatement() (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(IF)
+	if err != nil {
+		return nil, err
+	}
+
+	ifExpr, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	ifStmt, err := parser.statement()
+	if err != nil {
+		return nil, err
+	}
+
+	firstIf := NewIfStmt(ifExpr, ifStmt, *startPos.CreateSEPos(ifStmt.GetPos().End, ifStmt.GetPos().File))
+
+	stmts := make([]IfStmt, 0)
+	stmts = append(stmts, *firstIf)
+
+	for parser.currentToken.TType == ELIF {
+		elifStartPos := parser.currentToken.Pos.Start
+
+		parser.advance()
+
+		elifExpr, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		elifStmt, err := parser.statement()
+		if err != nil {
+			return nil, err
+		}
+
+		stmts = append(stmts, *NewIfStmt(
+			elifExpr,
+			elifStmt,
+			*elifStartPos.CreateSEPos(elifStmt.GetPos().End, elifExpr.GetPosition().File),
+		))
+	}
+
+	if parser.currentToken.TType == ELSE {
+		elseStartPos := parser.currentToken.Pos.Start
+
+		parser.advance()
+
+		elseStmt, err := parser.statement()
+		if err != nil {
+			return nil, err
+		}
+
+		stmts = append(stmts, *NewIfStmt(
+			nil,
+			elseStmt,
+			*elseStartPos.CreateSEPos(elseStmt.GetPos().End, elseStmt.GetPos().File),
+		))
+	}
+
+	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err := parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewIfStmtContainer(stmts, *startPos.CreateSEPos(stmts[len(stmts)-1].Pos.End, firstIf.Pos.File)), nil
+}
+
+func (parser *Parser) labeledStatement() (Stmt, error) {
+	label := parser.currentToken
+
+	parser.advance()
+	parser.advance()
+
+	for _, name := range parser.labels {
+		if name == label.Value {
+			return nil, NewSnowError(
+				INVALID_LABEL_ERROR,
+				fmt.Sprintf("the label '%s' is already used by an outer loop", label.Value),
+				"Give nested loops different labels",
+				label.Pos,
+			)
+		}
+	}
+
+	labels := parser.labels
+	parser.labels = append(parser.labels, label.Value)
+
+	var stmt Stmt
+	var err error
+	if parser.currentToken.TType == WHILE {
+		stmt, err = parser.whileStatement(&label)
+	} else if parser.currentToken.TType == FOR {
+		stmt, err = parser.forStatement(&label)
+	} else {
+		err = NewSnowError(
+			INVALID_LABEL_ERROR,
+			fmt.Sprintf("the label '%s' must be followed by a loop, not a token of type '%s'", label.Value, parser.currentToken.TType),
+			"Only while and for loops can have labels",
+			label.Pos,
 		)
 	}
 
-	var value Expr
-	endPos := parser.currentToken.Pos.End
-	if parser.currentToken.TType != NEWLINE {
-		value, err = parser.expression()
+	parser.labels = labels
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+func (parser *Parser) loopLabel() (*Token, error) {
+	if parser.currentToken.TType != IDENTIFIER {
+		return nil, nil
+	}
+
+	label := parser.currentToken
+
+	parser.advance()
+
+	for _, name := range parser.labels {
+		if name == label.Value {
+			return &label, nil
+		}
+	}
+
+	return nil, NewSnowError(
+		UNDEFINED_LABEL_ERROR,
+		fmt.Sprintf("there is no loop with the label '%s' around this statement", label.Value),
+		"Labels are written before a loop like 'outer: while true { ... }'",
+		label.Pos,
+	)
+}
+
+func (parser *Parser) whileStatement(label *Token) (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(WHILE)
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	parser.inLoop += 1
+
+	stmt, err := parser.statement()
+	if err != nil {
+		return nil, err
+	}
+
+	parser.inLoop -= 1
+
+	return NewWhileStmt(label, stmt, expr, *startPos.CreateSEPos(stmt.GetPos().End, stmt.GetPos().File)), nil
+}
+
+func (parser *Parser) matchStatement() (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(MATCH)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(LCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.inBlock += 1
+
+	cases := make([]MatchCase, 0)
+	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		if parser.currentToken.TType == NEWLINE {
+			parser.advance()
+
+			continue
+		}
+
+		matchCase, err := parser.matchCase()
+		if err != nil {
+			return nil, err
+		}
+
+		cases = append(cases, *matchCase)
+	}
+
+	parser.inBlock -= 1
+
+	endPos := parser.currentToken.Pos
+
+	err = parser.consume(RCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.checkExhaustive(cases, *startPos.CreateSEPos(value.GetPosition().End, value.GetPosition().File))
+
+	return NewMatchStmt(value, cases, *startPos.CreateSEPos(endPos.End, endPos.File)), nil
+}
+
+func (parser *Parser) matchCase() (*MatchCase, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(CASE)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := make([]Pattern, 0)
+	for {
+		pattern, err := parser.pattern()
+		if err != nil {
+			return nil, err
+		}
+
+		err = parser.checkBindings(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, pattern)
+
+		if parser.currentToken.TType != COMMA {
+			break
+		}
+
+		parser.advance()
+	}
+
+	var guard Expr
+	if parser.currentToken.TType == IF {
+		parser.advance()
+
+		parser.inGuard = true
+
+		guard, err = parser.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		parser.inGuard = false
+	}
+
+	err = parser.consume(ARROW)
+	if err != nil {
+		return nil, err
+	}
+
+	var stmt Stmt
+	if parser.currentToken.TType == LCURLYBRACKET {
+		stmt, err = parser.blockStatement()
+	} else {
+		stmt, err = parser.statement()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMatchCase(patterns, guard, stmt, *startPos.CreateSEPos(stmt.GetPos().End, stmt.GetPos().File)), nil
+}
+
+func (parser *Parser) selectStatement() (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(SELECT)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(LCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.inBlock += 1
+
+	cases := make([]SelectCase, 0)
+	var elseStmt Stmt
+	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		if parser.currentToken.TType == NEWLINE {
+			parser.advance()
+
+			continue
+		}
+
+		if parser.currentToken.TType == ELSE && elseStmt == nil {
+			parser.advance()
+
+			elseStmt, err = parser.blockStatement()
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		selectCase, err := parser.selectCase()
+		if err != nil {
+			return nil, err
+		}
+
+		cases = append(cases, *selectCase)
+	}
+
+	parser.inBlock -= 1
+
+	endPos := parser.currentToken.Pos
+
+	err = parser.consume(RCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cases) == 0 {
+		return nil, NewSnowError(
+			INVALID_SELECT_CASE_ERROR,
+			"a select statement needs at least one case",
+			"Add a 'case channel.send(value)' or 'case channel.recv()' to the select statement",
+			*startPos.CreateSEPos(endPos.End, endPos.File),
+		)
+	}
+
+	return NewSelectStmt(cases, elseStmt, *startPos.CreateSEPos(endPos.End, endPos.File)), nil
+}
+
+func (parser *Parser) selectCase() (*SelectCase, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(CASE)
+	if err != nil {
+		return nil, err
+	}
+
+	var name *Token
+	if parser.currentToken.TType == VAR {
+		parser.advance()
+
+		if parser.currentToken.TType != IDENTIFIER {
+			return nil, NewUnexpectedTokenError(IDENTIFIER, parser.currentToken)
+		}
+
+		tok := parser.currentToken
+		name = &tok
+
+		parser.advance()
+
+		err = parser.consume(SINGLE_EQUALS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expr, err := parser.call()
+	if err != nil {
+		return nil, err
+	}
+
+	call, ok := expr.(*CallExpr)
+	var dot *DotExpr
+	if ok {
+		dot, ok = call.Function.(*DotExpr)
+	}
+
+	send := ok && dot.Right.Value == "send" && len(call.Arguments) == 1 && name == nil
+	recv := ok && dot.Right.Value == "recv" && len(call.Arguments) == 0
+	if !send && !recv {
+		return nil, NewSnowError(
+			INVALID_SELECT_CASE_ERROR,
+			"a select case must be a channel operation",
+			"Use 'case channel.send(value)', 'case channel.recv()' or 'case var name = channel.recv()'",
+			expr.GetPosition(),
+		)
+	}
+
+	var value Expr
+	if send {
+		if call.Arguments[0].Name != nil || call.Arguments[0].Spread {
+			return nil, NewSnowError(
+				INVALID_SELECT_CASE_ERROR,
+				"the value sent in a select case must be a positional argument",
+				"Remove the name or spread from the argument",
+				call.Arguments[0].Value.GetPosition(),
+			)
+		}
+
+		value = call.Arguments[0].Value
+	}
+
+	stmt, err := parser.blockStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSelectCase(name, dot.Left, send, value, stmt, *startPos.CreateSEPos(stmt.GetPos().End, stmt.GetPos().File)), nil
+}
+
+func (parser *Parser) checkExhaustive(cases []MatchCase, pos SEPos) {
+	hasTrue := false
+	hasFalse := false
+
+	for _, matchCase := range cases {
+		for _, pattern := range matchCase.Patterns {
+			switch pattern := pattern.(type) {
+			case *WildcardPattern, *BindingPattern:
+				if matchCase.Guard == nil {
+					return
+				}
+			case *LiteralPattern:
+				if boolLiteral, ok := pattern.Value.(*BoolLiteralExpr); ok && matchCase.Guard == nil {
+					if boolLiteral.Value {
+						hasTrue = true
+					} else {
+						hasFalse = true
+					}
+				}
+			}
+		}
+	}
+
+	missing := ""
+	if hasTrue && !hasFalse {
+		missing = "false"
+	} else if hasFalse && !hasTrue {
+		missing = "true"
+	} else {
+		return
+	}
+
+	parser.warnings = append(parser.warnings, *NewSnowError(
+		NON_EXHAUSTIVE_MATCH_WARNING,
+		fmt.Sprintf("the match statement does not handle the value '%s'", missing),
+		fmt.Sprintf("Add 'case %s' or 'case _' to handle every value", missing),
+		pos,
+	))
+}
+
+func (parser *Parser) checkBindings(pattern Pattern) error {
+	names := make(map[string]bool)
+
+	for _, name := range patternNames(pattern) {
+		if names[name.Value] {
+			return NewSnowError(
+				INVALID_PATTERN_ERROR,
+				fmt.Sprintf("the name '%s' is bound more than once in the same pattern", name.Value),
+				fmt.Sprintf("Use different names and a guard like 'if %s == other' to match equal values", name.Value),
+				name.Pos,
+			)
+		}
+
+		names[name.Value] = true
+	}
+
+	return nil
+}
+
+func (parser *Parser) pattern() (Pattern, error) {
+	pattern, err := parser.simplePattern()
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.patternDefault(pattern)
+}
+
+func (parser *Parser) patternDefault(pattern Pattern) (Pattern, error) {
+	if !parser.inDestructure || parser.currentToken.TType != SINGLE_EQUALS {
+		return pattern, nil
+	}
+
+	parser.advance()
+
+	def, err := parser.conditional()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDefaultPattern(pattern, def, *pattern.GetPosition().Start.CreateSEPos(def.GetPosition().End, def.GetPosition().File)), nil
+}
+
+func (parser *Parser) simplePattern() (Pattern, error) {
+	startToken := parser.currentToken
+
+	switch startToken.TType {
+	case IDENTIFIER:
+		if startToken.Value == "_" {
+			parser.advance()
+
+			return NewWildcardPattern(startToken.Pos), nil
+		} else if parser.peek().TType == LPAREN {
+			return parser.classPattern()
+		}
+
+		parser.advance()
+
+		return NewBindingPattern(startToken.Value, startToken.Pos), nil
+	case LBRACKET:
+		return parser.listPattern()
+	case LCURLYBRACKET:
+		return parser.mapPattern()
+	case LPAREN:
+		return parser.tuplePattern()
+	case INT, FLOAT, STRING, TRUE, FALSE, NULL:
+		value, err := parser.primary()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewLiteralPattern(value, value.GetPosition()), nil
+	case DASH:
+		if parser.peek().TType == INT || parser.peek().TType == FLOAT {
+			parser.advance()
+
+			right, err := parser.primary()
+			if err != nil {
+				return nil, err
+			}
+
+			pos := *startToken.Pos.Start.CreateSEPos(right.GetPosition().End, right.GetPosition().File)
+
+			return NewLiteralPattern(NewUnaryExpr(startToken, right, pos), pos), nil
+		}
+	}
+
+	return nil, NewSnowError(
+		INVALID_PATTERN_ERROR,
+		fmt.Sprintf("expected a pattern, but instead got token of type '%s'", startToken.TType),
+		"Patterns can be literals, '_', names, lists, tuples, maps or class patterns like 'Point(x: 0)'",
+		startToken.Pos,
+	)
+}
+
+func (parser *Parser) listPattern() (Pattern, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(LBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.skipNewlines()
+
+	before := make([]Pattern, 0)
+	after := make([]Pattern, 0)
+	var rest *Token
+
+	for parser.currentToken.TType != RBRACKET && parser.currentToken.TType != EOF {
+		if parser.currentToken.TType == ELLIPSIS {
+			if rest != nil {
+				return nil, NewSnowError(
+					INVALID_PATTERN_ERROR,
+					"a list pattern can only have one rest pattern",
+					"",
+					parser.currentToken.Pos,
+				)
+			}
+
+			parser.advance()
+
+			name := parser.currentToken
+			err = parser.consume(IDENTIFIER)
+			if err != nil {
+				return nil, err
+			}
+
+			rest = &name
+		} else {
+			pattern, err := parser.pattern()
+			if err != nil {
+				return nil, err
+			}
+
+			if rest == nil {
+				before = append(before, pattern)
+			} else {
+				after = append(after, pattern)
+			}
+		}
+
+		parser.skipNewlines()
+
+		if parser.currentToken.TType != RBRACKET {
+			err = parser.consume(COMMA)
+			if err != nil {
+				return nil, err
+			}
+
+			parser.skipNewlines()
+		}
+	}
+
+	endPos := parser.currentToken.Pos
+
+	err = parser.consume(RBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewListPattern(before, rest, after, *startPos.CreateSEPos(endPos.End, endPos.File)), nil
+}
+
+func (parser *Parser) tuplePattern() (Pattern, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(LPAREN)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.skipNewlines()
+
+	elements := make([]Pattern, 0)
+	tuple := false
+
+	for parser.currentToken.TType != RPAREN && parser.currentToken.TType != EOF {
+		pattern, err := parser.pattern()
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, pattern)
+
+		parser.skipNewlines()
+
+		if parser.currentToken.TType != RPAREN {
+			err = parser.consume(COMMA)
+			if err != nil {
+				return nil, err
+			}
+
+			tuple = true
+
+			parser.skipNewlines()
+		}
+	}
+
+	endPos := parser.currentToken.Pos
+
+	err = parser.consume(RPAREN)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elements) == 1 && !tuple {
+		return elements[0], nil
+	}
+
+	return NewTuplePattern(elements, *startPos.CreateSEPos(endPos.End, endPos.File)), nil
+}
+
+func (parser *Parser) mapPattern() (Pattern, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(LCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	parser.skipNewlines()
+
+	keys := make([]Expr, 0)
+	values := make([]Pattern, 0)
+
+	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		var key Expr
+		var value Pattern
+
+		switch parser.currentToken.TType {
+		case IDENTIFIER:
+			name := parser.currentToken
+			key = NewStringLiteralExpr(name.Value, name.Pos)
+
+			parser.advance()
+
+			if parser.currentToken.TType != COLON {
+				value, err = parser.patternDefault(NewBindingPattern(name.Value, name.Pos))
+				if err != nil {
+					return nil, err
+				}
+			}
+		case STRING, INT, FLOAT, TRUE, FALSE, NULL:
+			key, err = parser.primary()
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, NewSnowError(
+				INVALID_PATTERN_ERROR,
+				fmt.Sprintf("expected a map pattern key, but instead got token of type '%s'", parser.currentToken.TType),
+				"Map pattern keys can be names or literals",
+				parser.currentToken.Pos,
+			)
+		}
+
+		if value == nil {
+			err = parser.consume(COLON)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err = parser.pattern()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		keys = append(keys, key)
+		values = append(values, value)
+
+		parser.skipNewlines()
+
+		if parser.currentToken.TType != RCURLYBRACKET {
+			err = parser.consume(COMMA)
+			if err != nil {
+				return nil, err
+			}
+
+			parser.skipNewlines()
+		}
+	}
+
+	endPos := parser.currentToken.Pos
+
+	err = parser.consume(RCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMapPattern(keys, values, *startPos.CreateSEPos(endPos.End, endPos.File)), nil
+}
+
+func (parser *Parser) classPattern() (Pattern, error) {
+	name := parser.currentToken
+
+	err := parser.consume(IDENTIFIER)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(LPAREN)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]Token, 0)
+	patterns := make([]Pattern, 0)
+
+	for parser.currentToken.TType != RPAREN && parser.currentToken.TType != EOF {
+		field := parser.currentToken
+
+		err = parser.consume(IDENTIFIER)
+		if err != nil {
+			return nil, err
+		}
+
+		err = parser.consume(COLON)
+		if err != nil {
+			return nil, err
+		}
+
+		pattern, err := parser.pattern()
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, field)
+		patterns = append(patterns, pattern)
+
+		if parser.currentToken.TType != RPAREN {
+			err = parser.consume(COMMA)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	endPos := parser.currentToken.Pos
+
+	err = parser.consume(RPAREN)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClassPattern(NewVarAccessExpr(name.Value, name.Pos), fields, patterns, *name.Pos.Start.CreateSEPos(endPos.End, endPos.File)), nil
+}
+
+func (parser *Parser) forStatement(label *Token) (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(FOR)
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := parser.currentToken
+	err = parser.consume(IDENTIFIER)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(IN)
+	if err != nil {
+		return nil, err
+	}
+
+	iterable, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	parser.inLoop += 1
+
+	stmt, err := parser.statement()
+	if err != nil {
+		return nil, err
+	}
+
+	parser.inLoop -= 1
+
+	return NewForStmt(label, identifier, iterable, stmt, *startPos.CreateSEPos(stmt.GetPos().End, stmt.GetPos().File)), nil
+}
+
+func (parser *Parser) blockStatement(params ...string) (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+	file := parser.currentToken.Pos.File
+	statements := make([]Stmt, 0)
+
+	err := parser.consume(LCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.currentToken.TType == NEWLINE {
+		parser.advance()
+	}
+
+	parser.inBlock += 1
+
+	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		if parser.currentToken.TType == NEWLINE {
+			parser.advance()
+
+			continue
+		}
+
+		statement, err := parser.deceleration()
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	}
+
+	parser.inBlock -= 1
+
+	if parser.currentToken.TType != RCURLYBRACKET {
+		return nil, NewUnexpectedTokenError(RCURLYBRACKET, parser.currentToken)
+	}
+
+	endPos := parser.currentToken.Pos
+
+	parser.advance()
+
+	name := ""
+	if len(params) != 0 {
+		name = params[0]
+	}
+
+	return NewBlockStmt(statements, name, *startPos.CreateSEPos(endPos.End, file)), nil
+}
+
+func (parser *Parser) expressionStmt() (Stmt, error) {
+	pos := parser.currentToken.Pos
+
+	expression, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType != EOF && !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewExpressionStmt(expression, pos), nil
+}
+
+func (parser *Parser) returnStmt() (Stmt, error) {
+	pos := parser.currentToken.Pos
+
+	err := parser.consume(RETURN)
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.inBlock == 0 {
+		return nil, NewSnowError(
+			RETURN_OUTSIDE_OF_LOOP_ERROR,
+			"return statement found outside of block",
+			"Return statements can only be used inside of blocks",
+			pos,
+		)
+	}
+
+	var value Expr
+	endPos := parser.currentToken.Pos.End
+	if parser.currentToken.TType != NEWLINE && parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		value, err = parser.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		if parser.currentToken.TType == COMMA {
+			elements := []Expr{value}
+			for parser.currentToken.TType == COMMA {
+				parser.advance()
+
+				element, err := parser.expression()
+				if err != nil {
+					return nil, err
+				}
+
+				elements = append(elements, element)
+			}
+
+			value = NewTupleLiteralExpr(elements, *value.GetPosition().Start.CreateSEPos(elements[len(elements)-1].GetPosition().End, parser.file))
+		}
+
+		endPos = value.GetPosition().End
+	}
+
+	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewReturnStmt(value, *pos.Start.CreateSEPos(endPos, parser.currentToken.Pos.File)), nil
+}
+
+func (parser *Parser) yieldStmt() (Stmt, error) {
+	pos := parser.currentToken.Pos
+
+	err := parser.consume(YIELD)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parser.generators) == 0 {
+		return nil, NewSnowError(
+			YIELD_OUTSIDE_OF_FUNCTION_ERROR,
+			"yield statement found outside of function",
+			"Yield statements can only be used inside of functions",
+			pos,
+		)
+	}
+
+	parser.generators[len(parser.generators)-1] = true
+
+	var value Expr
+	endPos := parser.currentToken.Pos.End
+	if parser.currentToken.TType != NEWLINE && parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		value, err = parser.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		endPos = value.GetPosition().End
+	}
+
+	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewYieldStmt(value, *pos.Start.CreateSEPos(endPos, parser.currentToken.Pos.File)), nil
+}
+
+func (parser *Parser) throwStmt() (Stmt, error) {
+	pos := parser.currentToken.Pos
+
+	err := parser.consume(THROW)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.endStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewThrowStmt(value, *pos.Start.CreateSEPos(value.GetPosition().End, pos.File)), nil
+}
+
+func (parser *Parser) tryStatement() (Stmt, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(TRY)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := parser.blockStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	endPos := stmt.GetPos().End
+	catches := make([]CatchClause, 0)
+
+	for parser.currentToken.TType == CATCH {
+		catchClause, err := parser.catchClause()
+		if err != nil {
+			return nil, err
+		}
+
+		endPos = catchClause.Pos.End
+		catches = append(catches, *catchClause)
+	}
+
+	var finally Stmt
+	if parser.currentToken.TType == FINALLY {
+		parser.advance()
+
+		finally, err = parser.blockStatement()
+		if err != nil {
+			return nil, err
+		}
+
+		endPos = finally.GetPos().End
+	}
+
+	pos := *startPos.CreateSEPos(endPos, stmt.GetPos().File)
+
+	if len(catches) == 0 && finally == nil {
+		return nil, NewSnowError(
+			MISSING_CATCH_ERROR,
+			"try statement found without a catch or finally block",
+			"Add a 'catch (error) { }' or 'finally { }' block after the try block",
+			pos,
+		)
+	}
+
+	return NewTryStmt(stmt, catches, finally, pos), nil
+}
+
+func (parser *Parser) catchClause() (*CatchClause, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(CATCH)
+	if err != nil {
+		return nil, err
+	}
+
+	var name *Token
+	types := make([]Expr, 0)
+
+	if parser.currentToken.TType == LPAREN {
+		parser.advance()
+
+		nameToken := parser.currentToken
+		err = parser.consume(IDENTIFIER)
+		if err != nil {
+			return nil, err
+		}
+
+		name = &nameToken
+
+		if parser.currentToken.TType == COLON {
+			parser.advance()
+
+			catchType, err := parser.call()
+			if err != nil {
+				return nil, err
+			}
+
+			types = append(types, catchType)
+
+			for parser.currentToken.TType == PIPE {
+				parser.advance()
+
+				catchType, err := parser.call()
+				if err != nil {
+					return nil, err
+				}
+
+				types = append(types, catchType)
+			}
+		}
+
+		err = parser.consume(RPAREN)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stmt, err := parser.blockStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCatchClause(name, types, stmt, *startPos.CreateSEPos(stmt.GetPos().End, stmt.GetPos().File)), nil
+}
+
+func (parser *Parser) breakStmt() (Stmt, error) {
+	pos := parser.currentToken.Pos
+
+	err := parser.consume(BREAK)
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.inLoop == 0 {
+		return nil, NewSnowError(
+			BREAK_OUTSIDE_OF_LOOP_ERROR,
+			"break statement found outside of loop",
+			"Break statements can only be used inside of loops",
+			pos,
+		)
+	}
+
+	label, err := parser.loopLabel()
+	if err != nil {
+		return nil, err
+	}
+
+	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewBreakStmt(label, pos), nil
+}
+
+func (parser *Parser) continueStmt() (Stmt, error) {
+	pos := parser.currentToken.Pos
+
+	err := parser.consume(CONTINUE)
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.inLoop == 0 {
+		return nil, NewSnowError(
+			CONTINUE_OUTSIDE_OF_LOOP_ERROR,
+			"continue statement found outside of loop",
+			"Continue statements can only be used inside of loops",
+			pos,
+		)
+	}
+
+	label, err := parser.loopLabel()
+	if err != nil {
+		return nil, err
+	}
+
+	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
+		err = parser.consume(NEWLINE)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewContinueStmt(label, pos), nil
+}
+
+func (parser *Parser) expression() (Expr, error) {
+	assignment, err := parser.assignment()
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (parser *Parser) assignment() (Expr, error) {
+	logicOr, err := parser.conditional()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType == SINGLE_EQUALS {
+		switch logicOr.(type) {
+		case *DotExpr, *IndexExpr, *VarAccessExpr:
+			parser.advance()
+
+			val, err := parser.expression()
+			if err != nil {
+				return nil, err
+			}
+
+			return parser.makeAssignment(logicOr, val, nil, false, *logicOr.GetPosition().Start.CreateSEPos(val.GetPosition().End, logicOr.GetPosition().File))
+		case *ListLiteralExpr, *TupleLiteralExpr, *MapLiteralExpr:
+			pattern, err := parser.assignmentPattern(logicOr)
+			if err != nil {
+				return nil, err
+			}
+
+			parser.advance()
+
+			val, err := parser.expression()
+			if err != nil {
+				return nil, err
+			}
+
+			return NewDestructuringAssignmentExpr(pattern, val, *logicOr.GetPosition().Start.CreateSEPos(val.GetPosition().End, logicOr.GetPosition().File)), nil
+		default:
+			return logicOr, nil
+		}
+	}
+
+	if op, ok := compoundOperators[parser.currentToken.TType]; ok {
+		opToken := *NewToken(op, "", parser.currentToken.Pos)
+
+		parser.advance()
+
+		val, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		return parser.makeAssignment(logicOr, val, &opToken, false, *logicOr.GetPosition().Start.CreateSEPos(val.GetPosition().End, logicOr.GetPosition().File))
+	}
+
+	return logicOr, nil
+}
+
+var compoundOperators = map[TokenType]TokenType{
+	PLUS_EQUALS:    PLUS,
+	DASH_EQUALS:    DASH,
+	STAR_EQUALS:    STAR,
+	SLASH_EQUALS:   SLASH,
+	PERCENT_EQUALS: PERCENT,
+	DOUBLE_PLUS:    PLUS,
+	DOUBLE_DASH:    DASH,
+}
+
+func (parser *Parser) makeAssignment(target Expr, value Expr, op *Token, postfix bool, pos SEPos) (Expr, error) {
+	switch target := target.(type) {
+	case *DotExpr:
+		return NewVarAssignmentExpr(target.Left, target.Right.Value, value, op, postfix, pos), nil
+	case *IndexExpr:
+		return NewIndexAssignmentExpr(target.Object, target.Index, value, op, postfix, pos), nil
+	case *VarAccessExpr:
+		return NewVarAssignmentExpr(nil, target.Value, value, op, postfix, pos), nil
+	}
+
+	return nil, NewSnowError(
+		INVALID_ASSIGNMENT_TARGET_ERROR,
+		"unable to assign to this expression",
+		"Only variables, attributes and indexes can be assigned to",
+		target.GetPosition(),
+	)
+}
+
+func (parser *Parser) assignmentPattern(target Expr) (Pattern, error) {
+	switch target := target.(type) {
+	case *VarAccessExpr:
+		if target.Value == "_" {
+			return NewWildcardPattern(target.Pos), nil
+		}
+
+		return NewBindingPattern(target.Value, target.Pos), nil
+	case *VarAssignmentExpr:
+		if target.Object == nil && target.Pattern == nil && target.Op == nil {
+			return NewDefaultPattern(NewBindingPattern(target.Name, target.Pos), target.Value, target.Pos), nil
+		}
+	case *ListLiteralExpr:
+		elements := make([]Pattern, 0, len(target.Elements))
+		for _, element := range target.Elements {
+			pattern, err := parser.assignmentPattern(element)
+			if err != nil {
+				return nil, err
+			}
+
+			elements = append(elements, pattern)
+		}
+
+		return NewListPattern(elements, nil, nil, target.Pos), nil
+	case *TupleLiteralExpr:
+		elements := make([]Pattern, 0, len(target.Elements))
+		for _, element := range target.Elements {
+			pattern, err := parser.assignmentPattern(element)
+			if err != nil {
+				return nil, err
+			}
+
+			elements = append(elements, pattern)
+		}
+
+		return NewTuplePattern(elements, target.Pos), nil
+	case *MapLiteralExpr:
+		keys := make([]Expr, 0, len(target.Keys))
+		for _, key := range target.Keys {
+			if name, ok := key.(*VarAccessExpr); ok {
+				key = NewStringLiteralExpr(name.Value, name.Pos)
+			}
+
+			keys = append(keys, key)
+		}
+
+		values := make([]Pattern, 0, len(target.Values))
+		for _, value := range target.Values {
+			pattern, err := parser.assignmentPattern(value)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, pattern)
+		}
+
+		return NewMapPattern(keys, values, target.Pos), nil
+	}
+
+	return nil, NewSnowError(
+		INVALID_ASSIGNMENT_TARGET_ERROR,
+		"unable to destructure into this expression",
+		"Only variables, lists, tuples and maps of variables can be destructured into",
+		target.GetPosition(),
+	)
+}
+
+func (parser *Parser) increment(target Expr, opToken Token, postfix bool, pos SEPos) (Expr, error) {
+	op := *NewToken(compoundOperators[opToken.TType], "", opToken.Pos)
+
+	return parser.makeAssignment(target, NewIntLiteralExpr(1, opToken.Pos), &op, postfix, pos)
+}
+
+func (parser *Parser) conditional() (Expr, error) {
+	condition, err := parser.logicOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType != QUESTION {
+		return condition, nil
+	}
+
+	parser.advance()
+
+	then, err := parser.conditional()
+	if err != nil {
+		return nil, err
+	}
+
+	err = parser.consume(COLON)
+	if err != nil {
+		return nil, err
+	}
+
+	elseExpr, err := parser.conditional()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConditionalExpr(
+		condition,
+		then,
+		elseExpr,
+		*condition.GetPosition().Start.CreateSEPos(elseExpr.GetPosition().End, elseExpr.GetPosition().File),
+	), nil
+}
+
+func (parser *Parser) logical(tType TokenType, function func() (Expr, error)) (Expr, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	left, err := function()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.currentToken.TType == tType {
+		opToken := parser.currentToken
+		parser.advance()
+
+		right, err := function()
+		if err != nil {
+			return nil, err
+		}
+
+		left = NewLogicalExpr(
+			left,
+			right,
+			opToken,
+			*startPos.CreateSEPos(right.GetPosition().End, parser.currentToken.Pos.File),
+		)
+	}
+
+	return left, nil
+}
+
+func (parser *Parser) logicOr() (Expr, error) {
+	logicAnd, err := parser.logical(OR, parser.logicAnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return logicAnd, nil
+}
+
+func (parser *Parser) logicAnd() (Expr, error) {
+	equality, err := parser.logical(AND, parser.equality)
+	if err != nil {
+		return nil, err
+	}
+
+	return equality, nil
+}
+
+func (parser *Parser) equality() (Expr, error) {
+	binary, err := parser.binary(
+		EQUALS,
+		NOT_EQUALS,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		parser.comparison,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) comparison() (Expr, error) {
+	binary, err := parser.binary(
+		GREATER_THAN,
+		GREATER_THAN_EQUALS,
+		LESS_THAN,
+		LESS_THAN_EQUALS,
+		parser.bitwiseOr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) bitwiseOr() (Expr, error) {
+	binary, err := parser.binary(
+		PIPE,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		parser.bitwiseXor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) bitwiseXor() (Expr, error) {
+	binary, err := parser.binary(
+		CARET,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		parser.bitwiseAnd,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) bitwiseAnd() (Expr, error) {
+	binary, err := parser.binary(
+		AMPERSAND,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		parser.shift,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) shift() (Expr, error) {
+	binary, err := parser.binary(
+		LEFT_SHIFT,
+		RIGHT_SHIFT,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		parser.term,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) term() (Expr, error) {
+	binary, err := parser.binary(
+		PLUS,
+		DASH,
+		PLACEHOLDER,
+		PLACEHOLDER,
+		parser.factor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) factor() (Expr, error) {
+	binary, err := parser.binary(
+		STAR,
+		SLASH,
+		PERCENT,
+		DOUBLE_SLASH,
+		parser.unary,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary, nil
+}
+
+func (parser *Parser) unary() (Expr, error) {
+	if parser.currentToken.TType == DOUBLE_PLUS || parser.currentToken.TType == DOUBLE_DASH {
+		token := parser.currentToken
+
+		parser.advance()
+
+		target, err := parser.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return parser.increment(target, token, false, *token.Pos.Start.CreateSEPos(target.GetPosition().End, token.Pos.File))
+	}
+
+	if parser.currentToken.TType == DASH || parser.currentToken.TType == NOT || parser.currentToken.TType == TILDE {
+		token := parser.currentToken
+
+		parser.advance()
+
+		right, err := parser.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewUnaryExpr(
+			token,
+			right,
+			*token.Pos.Start.CreateSEPos(right.GetPosition().End, parser.currentToken.Pos.File),
+		), nil
+	}
+
+	if parser.currentToken.TType == SPAWN {
+		token := parser.currentToken
+
+		parser.advance()
+
+		expr, err := parser.postfix()
+		if err != nil {
+			return nil, err
+		}
+
+		call, ok := expr.(*CallExpr)
+		if !ok {
+			return nil, NewSnowError(
+				INVALID_SPAWN_ERROR,
+				"spawn must be followed by a function call",
+				"Call the function you want to run concurrently, for example 'spawn f(x)'",
+				expr.GetPosition(),
+			)
+		}
+
+		return NewSpawnExpr(call, *token.Pos.Start.CreateSEPos(call.GetPosition().End, token.Pos.File)), nil
+	}
+
+	if parser.currentToken.TType == AWAIT {
+		token := parser.currentToken
+
+		parser.advance()
+
+		value, err := parser.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewAwaitExpr(value, *token.Pos.Start.CreateSEPos(value.GetPosition().End, token.Pos.File)), nil
+	}
+
+	power, err := parser.power()
+	if err != nil {
+		return nil, err
+	}
+
+	return power, nil
+}
+
+func (parser *Parser) power() (Expr, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	left, err := parser.postfix()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType == DOUBLE_STAR {
+		opToken := parser.currentToken
+		parser.advance()
+
+		right, err := parser.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewBinaryExpr(
+			left,
+			right,
+			opToken,
+			*startPos.CreateSEPos(right.GetPosition().End, parser.currentToken.Pos.File),
+		), nil
+	}
+
+	return left, nil
+}
+
+func (parser *Parser) postfix() (Expr, error) {
+	call, err := parser.call()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.TType == DOUBLE_PLUS || parser.currentToken.TType == DOUBLE_DASH {
+		token := parser.currentToken
+
+		parser.advance()
+
+		return parser.increment(call, token, true, *call.GetPosition().Start.CreateSEPos(token.Pos.End, token.Pos.File))
+	}
+
+	return call, nil
+}
+
+func (parser *Parser) call() (Expr, error) {
+	primary, err := parser.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.currentToken.TType == DOT || parser.currentToken.TType == LPAREN || parser.currentToken.TType == LBRACKET {
+		if parser.currentToken.TType == LBRACKET {
+			primary, err = parser.indexOrSlice(primary)
+			if err != nil {
+				return nil, err
+			}
+		} else if parser.currentToken.TType == DOT {
+			parser.advance()
+
+			if parser.currentToken.TType != IDENTIFIER {
+				return nil, NewUnexpectedTokenError(IDENTIFIER, parser.currentToken)
+			}
+
+			right := parser.currentToken
+
+			parser.advance()
+
+			primary = NewDotExpr(primary, right, *primary.GetPosition().Start.CreateSEPos(right.Pos.End, right.Pos.File))
+		} else {
+			parser.advance()
+
+			arguments, err := parser.arguments()
+			if err != nil {
+				return nil, err
+			}
+
+			endPos := parser.currentToken.Pos.End
+
+			err = parser.consume(RPAREN)
+			if err != nil {
+				return nil, err
+			}
+
+			primary = NewCallExpr(primary, arguments, *primary.GetPosition().Start.CreateSEPos(endPos, primary.GetPosition().File))
+		}
+	}
+
+	return primary, err
+}
+
+func (parser *Parser) arguments() ([]Argument, error) {
+	arguments := make([]Argument, 0)
+	named := false
+
+	for parser.currentToken.TType != RPAREN && parser.currentToken.TType != EOF {
+		var name *Token
+		spread := false
+
+		if parser.currentToken.TType == ELLIPSIS {
+			spread = true
+
+			parser.advance()
+		} else if parser.currentToken.TType == IDENTIFIER && parser.peek().TType == COLON {
+			tok := parser.currentToken
+			name = &tok
+
+			parser.advance()
+			parser.advance()
+		}
+
+		if name != nil {
+			named = true
+		} else if named {
+			return nil, NewSnowError(
+				ARGUMENT_ERROR,
+				"a positional argument can not follow a named argument",
+				"Pass positional arguments before named arguments",
+				parser.currentToken.Pos,
+			)
+		}
+
+		value, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
+
+		arguments = append(arguments, *NewArgument(name, value, spread))
+
+		if parser.currentToken.TType != RPAREN {
+			err = parser.consume(COMMA)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return arguments, nil
+}
+
+func (parser *Parser) interpolatedString() (Expr, error) {
+	var str Expr = NewStringLiteralExpr(parser.currentToken.Value, parser.currentToken.Pos)
+
+	parser.advance()
+
+	for {
+		value, err := parser.expression()
 		if err != nil {
 			return nil, err
 		}
 
-		endPos = value.GetPosition().End
-	}
+		part := parser.currentToken
+		if part.TType != STRING_MIDDLE && part.TType != STRING_END {
+			return nil, NewUnexpectedTokenError(STRING_END, part)
+		}
 
-	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
-		err = parser.consume(NEWLINE)
-		if err != nil {
-			return nil, err
+		parser.advance()
+
+		pos := value.GetPosition()
+		str = NewBinaryExpr(str, NewStringifyExpr(value, pos), *NewToken(PLUS, "", pos), *str.GetPosition().Start.CreateSEPos(pos.End, pos.File))
+
+		if part.Value != "" {
+			str = NewBinaryExpr(str, NewStringLiteralExpr(part.Value, part.Pos), *NewToken(PLUS, "", part.Pos), *str.GetPosition().Start.CreateSEPos(part.Pos.End, part.Pos.File))
 		}
-	}
 
-	return NewReturnStmt(value, *pos.Start.CreateSEPos(endPos, parser.currentToken.Pos.File)), nil
+		if part.TType == STRING_END {
+			return str, nil
+		}
+	}
 }
 
-func (parser *Parser) breakStmt() (Stmt, error) {
-	pos := parser.currentToken.Pos
-
-	err := parser.consume(BREAK)
+func (parser *Parser) indexOrSlice(object Expr) (Expr, error) {
+	err := parser.consume(LBRACKET)
 	if err != nil {
 		return nil, err
 	}
 
-	if parser.inLoop == 0 {
-		return nil, NewSnowError(
-			BREAK_OUTSIDE_OF_LOOP_ERROR,
-			"break statement found outside of loop",
-			"Break statements can only be used inside of loops",
-			pos,
-		)
-	}
-
-	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
-		err = parser.consume(NEWLINE)
+	var start Expr
+	if parser.currentToken.TType != COLON {
+		start, err = parser.expression()
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return NewBreakStmt(pos), nil
-}
+	if parser.currentToken.TType != COLON {
+		endPos := parser.currentToken.Pos.End
 
-func (parser *Parser) continueStmt() (Stmt, error) {
-	pos := parser.currentToken.Pos
+		err = parser.consume(RBRACKET)
+		if err != nil {
+			return nil, err
+		}
 
-	err := parser.consume(CONTINUE)
-	if err != nil {
-		return nil, err
+		return NewIndexExpr(object, start, *object.GetPosition().Start.CreateSEPos(endPos, object.GetPosition().File)), nil
 	}
 
-	if parser.inLoop == 0 {
-		return nil, NewSnowError(
-			CONTINUE_OUTSIDE_OF_LOOP_ERROR,
-			"continue statement found outside of loop",
-			"Continue statements can only be used inside of loops",
-			pos,
-		)
-	}
+	parser.advance()
 
-	if !(parser.inBlock != 0 && parser.currentToken.TType == RCURLYBRACKET) {
-		err = parser.consume(NEWLINE)
+	var end Expr
+	if parser.currentToken.TType != RBRACKET {
+		end, err = parser.expression()
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return NewContinueStmt(pos), nil
-}
+	endPos := parser.currentToken.Pos.End
 
-func (parser *Parser) expression() (Expr, error) {
-	assignment, err := parser.assignment()
+	err = parser.consume(RBRACKET)
 	if err != nil {
 		return nil, err
 	}
 
-	return assignment, nil
+	return NewSliceExpr(object, start, end, *object.GetPosition().Start.CreateSEPos(endPos, object.GetPosition().File)), nil
 }
 
-func (parser *Parser) assignment() (Expr, error) {
-	logicOr, err := parser.logicOr()
+func (parser *Parser) listLiteral() (Expr, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(LBRACKET)
 	if err != nil {
 		return nil, err
 	}
 
-	if parser.currentToken.TType == SINGLE_EQUALS {
-		switch logicOr := logicOr.(type) {
-		case *DotExpr:
-			parser.advance()
+	elements := make([]Expr, 0)
 
-			val, err := parser.expression()
-			if err != nil {
-				return nil, err
-			}
+	parser.skipNewlines()
 
-			return NewVarAssignmentExpr(
-				logicOr.Left,
-				logicOr.Right.Value,
-				val,
-				*logicOr.GetPosition().Start.CreateSEPos(val.GetPosition().End, logicOr.GetPosition().File),
-			), nil
-		case *VarAccessExpr:
-			parser.advance()
+	for parser.currentToken.TType != RBRACKET && parser.currentToken.TType != EOF {
+		element, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
 
-			val, err := parser.expression()
+		elements = append(elements, element)
+
+		parser.skipNewlines()
+
+		if parser.currentToken.TType != RBRACKET {
+			err = parser.consume(COMMA)
 			if err != nil {
 				return nil, err
 			}
 
-			return NewVarAssignmentExpr(
-				nil,
-				logicOr.Value,
-				val,
-				*logicOr.GetPosition().Start.CreateSEPos(val.GetPosition().End, logicOr.GetPosition().File),
-			), nil
-		default:
-			return logicOr, nil
+			parser.skipNewlines()
 		}
 	}
 
-	return logicOr, nil
-}
+	endPos := parser.currentToken.Pos.End
 
-func (parser *Parser) logicOr() (Expr, error) {
-	logicAnd, err := parser.logicAnd()
+	err = parser.consume(RBRACKET)
 	if err != nil {
 		return nil, err
 	}
 
-	return logicAnd, nil
+	return NewListLiteralExpr(elements, *startPos.CreateSEPos(endPos, parser.file)), nil
 }
 
-func (parser *Parser) logicAnd() (Expr, error) {
-	equality, err := parser.equality()
-	if err != nil {
-		return nil, err
-	}
+func (parser *Parser) tupleLiteral(startToken Token, first Expr) (Expr, error) {
+	elements := []Expr{first}
 
-	return equality, nil
-}
+	for parser.currentToken.TType == COMMA {
+		parser.advance()
 
-func (parser *Parser) equality() (Expr, error) {
-	binary, err := parser.binary(
-		EQUALS,
-		NOT_EQUALS,
-		PLACEHOLDER,
-		PLACEHOLDER,
-		parser.comparison,
-	)
-	if err != nil {
-		return nil, err
-	}
+		if parser.currentToken.TType == RPAREN {
+			break
+		}
 
-	return binary, nil
-}
+		element, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
 
-func (parser *Parser) comparison() (Expr, error) {
-	binary, err := parser.binary(
-		GREATER_THAN,
-		GREATER_THAN_EQUALS,
-		LESS_THAN,
-		LESS_THAN_EQUALS,
-		parser.term,
-	)
-	if err != nil {
-		return nil, err
+		elements = append(elements, element)
 	}
 
-	return binary, nil
-}
+	endPos := parser.currentToken.Pos.End
 
-func (parser *Parser) term() (Expr, error) {
-	binary, err := parser.binary(
-		PLUS,
-		DASH,
-		PLACEHOLDER,
-		PLACEHOLDER,
-		parser.factor,
-	)
+	err := parser.consume(RPAREN)
 	if err != nil {
 		return nil, err
 	}
 
-	return binary, nil
+	return NewTupleLiteralExpr(elements, *startToken.Pos.Start.CreateSEPos(endPos, startToken.Pos.File)), nil
 }
 
-func (parser *Parser) factor() (Expr, error) {
-	binary, err := parser.binary(
-		STAR,
-		SLASH,
-		PLACEHOLDER,
-		PLACEHOLDER,
-		parser.unary,
-	)
-	if err != nil {
-		return nil, err
+func (parser *Parser) isMapLiteral() bool {
+	index := parser.index + 1
+	for index < len(parser.tokens) && parser.tokens[index].TType == NEWLINE {
+		index++
 	}
 
-	return binary, nil
-}
-
-func (parser *Parser) unary() (Expr, error) {
-	if parser.currentToken.TType == DASH || parser.currentToken.TType == NOT {
-		token := parser.currentToken
+	if index >= len(parser.tokens) {
+		return false
+	}
 
-		parser.advance()
+	switch parser.tokens[index].TType {
+	case STRING, INT, FLOAT, TRUE, FALSE, NULL, IDENTIFIER, LPAREN, LBRACKET, DASH:
+	default:
+		return false
+	}
 
-		right, err := parser.unary()
-		if err != nil {
-			return nil, err
-		}
+	depth := 0
+	for ; index < len(parser.tokens); index++ {
+		switch parser.tokens[index].TType {
+		case LPAREN, LBRACKET, LCURLYBRACKET:
+			depth++
+		case RPAREN, RBRACKET:
+			depth--
+		case RCURLYBRACKET:
+			if depth == 0 {
+				return false
+			}
 
-		return NewUnaryExpr(
-			token,
-			right,
-			*token.Pos.Start.CreateSEPos(right.GetPosition().End, parser.currentToken.Pos.File),
-		), nil
-	}
+			depth--
+		case NEWLINE, EOF, QUESTION:
+			if depth == 0 {
+				return false
+			}
+		case COLON:
+			if depth == 0 {
+				next := parser.tokens[index+1].TType
 
-	call, err := parser.call()
-	if err != nil {
-		return nil, err
+				return next != WHILE && next != FOR
+			}
+		}
 	}
 
-	return call, nil
+	return false
 }
 
-func (parser *Parser) call() (Expr, error) {
-	primary, err := parser.primary()
+func (parser *Parser) mapLiteral() (Expr, error) {
+	startPos := parser.currentToken.Pos.Start
+
+	err := parser.consume(LCURLYBRACKET)
 	if err != nil {
 		return nil, err
 	}
 
-	for parser.currentToken.TType == DOT || parser.currentToken.TType == LPAREN {
-		if parser.currentToken.TType == DOT {
-			parser.advance()
-
-			if parser.currentToken.TType != IDENTIFIER {
-				return nil, NewUnexpectedTokenError(IDENTIFIER, parser.currentToken)
-			}
-
-			right := parser.currentToken
+	keys := make([]Expr, 0)
+	values := make([]Expr, 0)
 
-			parser.advance()
+	parser.skipNewlines()
 
-			primary = NewDotExpr(primary, right, *primary.GetPosition().Start.CreateSEPos(right.Pos.End, right.Pos.File))
-		} else {
-			parser.advance()
+	for parser.currentToken.TType != RCURLYBRACKET && parser.currentToken.TType != EOF {
+		key, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
 
-			arguments := make([]Expr, 0)
+		err = parser.consume(COLON)
+		if err != nil {
+			return nil, err
+		}
 
-			for parser.currentToken.TType != RPAREN && parser.currentToken.TType != EOF {
-				argument, err := parser.expression()
-				if err != nil {
-					return nil, err
-				}
+		parser.skipNewlines()
 
-				arguments = append(arguments, argument)
+		value, err := parser.expression()
+		if err != nil {
+			return nil, err
+		}
 
-				if parser.currentToken.TType != RPAREN {
-					err = parser.consume(COMMA)
-					if err != nil {
-						return nil, err
-					}
-				}
-			}
+		keys = append(keys, key)
+		values = append(values, value)
 
-			endPos := parser.currentToken.Pos.End
+		parser.skipNewlines()
 
-			err := parser.consume(RPAREN)
+		if parser.currentToken.TType != RCURLYBRACKET {
+			err = parser.consume(COMMA)
 			if err != nil {
 				return nil, err
 			}
 
-			primary = NewCallExpr(primary, arguments, *primary.GetPosition().Start.CreateSEPos(endPos, primary.GetPosition().File))
+			parser.skipNewlines()
 		}
 	}
 
-	return primary, err
+	endPos := parser.currentToken.Pos.End
+
+	err = parser.consume(RCURLYBRACKET)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMapLiteralExpr(keys, values, *startPos.CreateSEPos(endPos, parser.file)), nil
 }
 
 func (parser *Parser) primary() (Expr, error) {
@@ -721,12 +2764,17 @@ func (parser *Parser) primary() (Expr, error) {
 
 		intValue, err := strconv.Atoi(startToken.Value)
 		if err != nil {
-			return nil, NewSnowError(
-				TOO_BIG_VALUE_ERROR,
-				fmt.Sprintf("the value of number of type %s is too big", startToken.TType),
-				"",
-				startToken.Pos,
-			)
+			bigValue, ok := new(big.Int).SetString(startToken.Value, 10)
+			if !ok {
+				return nil, NewSnowError(
+					VALUE_ERROR,
+					fmt.Sprintf("'%s' is not a valid number of type %s", startToken.Value, startToken.TType),
+					"",
+					startToken.Pos,
+				)
+			}
+
+			return NewBigIntLiteralExpr(bigValue, startToken.Pos), nil
 		}
 
 		return NewIntLiteralExpr(intValue, startToken.Pos), nil
@@ -744,6 +2792,16 @@ func (parser *Parser) primary() (Expr, error) {
 		}
 
 		return NewFloatLiteralExpr(floatValue, startToken.Pos), nil
+	case STRING:
+		parser.advance()
+
+		return NewStringLiteralExpr(startToken.Value, startToken.Pos), nil
+	case STRING_START:
+		return parser.interpolatedString()
+	case NULL:
+		parser.advance()
+
+		return NewNullLiteralExpr(startToken.Pos), nil
 	case TRUE:
 		parser.advance()
 
@@ -752,14 +2810,32 @@ func (parser *Parser) primary() (Expr, error) {
 		parser.advance()
 
 		return NewBoolLiteralExpr(false, startToken.Pos), nil
+	case FUNCTION:
+		return parser.functionExpr()
 	case LPAREN:
+		if parser.isArrowFunction() {
+			return parser.arrowFunction()
+		}
+
 		parser.advance()
 
+		if parser.currentToken.TType == RPAREN {
+			endPos := parser.currentToken.Pos.End
+
+			parser.advance()
+
+			return NewTupleLiteralExpr(make([]Expr, 0), *startToken.Pos.Start.CreateSEPos(endPos, startToken.Pos.File)), nil
+		}
+
 		expr, err := parser.expression()
 		if err != nil {
 			return nil, err
 		}
 
+		if parser.currentToken.TType == COMMA {
+			return parser.tupleLiteral(startToken, expr)
+		}
+
 		endPos := parser.currentToken.Pos.End
 
 		err = parser.consume(RPAREN)
@@ -770,7 +2846,52 @@ func (parser *Parser) primary() (Expr, error) {
 		pos := startToken.Pos.Start.CreateSEPos(endPos, startToken.Pos.File)
 
 		return NewGroupingExpr(expr, *pos), nil
+	case THIS:
+		parser.advance()
+
+		if len(parser.classes) == 0 {
+			return nil, NewSnowError(
+				THIS_OUTSIDE_OF_CLASS_ERROR,
+				"this expression found outside of class",
+				"This expressions can only be used inside of class methods",
+				startToken.Pos,
+			)
+		}
+
+		return NewThisExpr(startToken.Pos), nil
+	case SUPER:
+		parser.advance()
+
+		if len(parser.classes) == 0 || !parser.classes[len(parser.classes)-1] {
+			return nil, NewSnowError(
+				SUPER_OUTSIDE_OF_CLASS_ERROR,
+				"super expression found outside of class with a superclass",
+				"Super expressions can only be used inside of methods of classes that inherit from another class",
+				startToken.Pos,
+			)
+		}
+
+		err := parser.consume(DOT)
+		if err != nil {
+			return nil, err
+		}
+
+		method := parser.currentToken
+		err = parser.consume(IDENTIFIER)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewSuperExpr(method, *startToken.Pos.Start.CreateSEPos(method.Pos.End, startToken.Pos.File)), nil
+	case LBRACKET:
+		return parser.listLiteral()
+	case LCURLYBRACKET:
+		return parser.mapLiteral()
 	case IDENTIFIER:
+		if parser.isArrowFunction() {
+			return parser.arrowFunction()
+		}
+
 		parser.advance()
 
 		return NewVarAccessExpr(startToken.Value, startToken.Pos), nil
@@ -0,0 +1,293 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type Pattern interface {
+	Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error)
+	ToString() string
+	GetPosition() SEPos
+}
+
+type PatternVisitor interface {
+	VisitLiteralPattern(pattern LiteralPattern, value RTValue, env *Environment) (bool, error)
+	VisitWildcardPattern(pattern WildcardPattern, value RTValue, env *Environment) (bool, error)
+	VisitBindingPattern(pattern BindingPattern, value RTValue, env *Environment) (bool, error)
+	VisitListPattern(pattern ListPattern, value RTValue, env *Environment) (bool, error)
+	VisitTuplePattern(pattern TuplePattern, value RTValue, env *Environment) (bool, error)
+	VisitMapPattern(pattern MapPattern, value RTValue, env *Environment) (bool, error)
+	VisitClassPattern(pattern ClassPattern, value RTValue, env *Environment) (bool, error)
+	VisitDefaultPattern(pattern DefaultPattern, value RTValue, env *Environment) (bool, error)
+}
+
+type LiteralPattern struct {
+	Value Expr
+	Pos   SEPos
+}
+
+func NewLiteralPattern(value Expr, pos SEPos) *LiteralPattern {
+	return &LiteralPattern{
+		Value: value,
+		Pos:   pos,
+	}
+}
+
+func (literalPattern LiteralPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitLiteralPattern(literalPattern, value, env)
+}
+
+func (literalPattern LiteralPattern) ToString() string {
+	return fmt.Sprintf("(LITERAL_PATTERN: %s)", literalPattern.Value.ToString())
+}
+
+func (literalPattern LiteralPattern) GetPosition() SEPos {
+	return literalPattern.Pos
+}
+
+type WildcardPattern struct {
+	Pos SEPos
+}
+
+func NewWildcardPattern(pos SEPos) *WildcardPattern {
+	return &WildcardPattern{
+		Pos: pos,
+	}
+}
+
+func (wildcardPattern WildcardPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitWildcardPattern(wildcardPattern, value, env)
+}
+
+func (wildcardPattern WildcardPattern) ToString() string {
+	return "(WILDCARD_PATTERN)"
+}
+
+func (wildcardPattern WildcardPattern) GetPosition() SEPos {
+	return wildcardPattern.Pos
+}
+
+type BindingPattern struct {
+	Name string
+	Pos  SEPos
+}
+
+func NewBindingPattern(name string, pos SEPos) *BindingPattern {
+	return &BindingPattern{
+		Name: name,
+		Pos:  pos,
+	}
+}
+
+func (bindingPattern BindingPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitBindingPattern(bindingPattern, value, env)
+}
+
+func (bindingPattern BindingPattern) ToString() string {
+	return fmt.Sprintf("(BINDING_PATTERN: %s)", bindingPattern.Name)
+}
+
+func (bindingPattern BindingPattern) GetPosition() SEPos {
+	return bindingPattern.Pos
+}
+
+type ListPattern struct {
+	Before []Pattern
+	Rest   *Token
+	After  []Pattern
+	Pos    SEPos
+}
+
+func NewListPattern(before []Pattern, rest *Token, after []Pattern, pos SEPos) *ListPattern {
+	return &ListPattern{
+		Before: before,
+		Rest:   rest,
+		After:  after,
+		Pos:    pos,
+	}
+}
+
+func (listPattern ListPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitListPattern(listPattern, value, env)
+}
+
+func (listPattern ListPattern) ToString() string {
+	s := "["
+	for _, pattern := range listPattern.Before {
+		s += pattern.ToString() + " "
+	}
+
+	if listPattern.Rest != nil {
+		s += "..." + listPattern.Rest.Value + " "
+
+		for _, pattern := range listPattern.After {
+			s += pattern.ToString() + " "
+		}
+	}
+	s += "]"
+
+	return fmt.Sprintf("(LIST_PATTERN: %s)", s)
+}
+
+func (listPattern ListPattern) GetPosition() SEPos {
+	return listPattern.Pos
+}
+
+type TuplePattern struct {
+	Elements []Pattern
+	Pos      SEPos
+}
+
+func NewTuplePattern(elements []Pattern, pos SEPos) *TuplePattern {
+	return &TuplePattern{
+		Elements: elements,
+		Pos:      pos,
+	}
+}
+
+func (tuplePattern TuplePattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitTuplePattern(tuplePattern, value, env)
+}
+
+func (tuplePattern TuplePattern) ToString() string {
+	s := "("
+	for _, pattern := range tuplePattern.Elements {
+		s += pattern.ToString() + " "
+	}
+	s += ")"
+
+	return fmt.Sprintf("(TUPLE_PATTERN: %s)", s)
+}
+
+func (tuplePattern TuplePattern) GetPosition() SEPos {
+	return tuplePattern.Pos
+}
+
+type MapPattern struct {
+	Keys   []Expr
+	Values []Pattern
+	Pos    SEPos
+}
+
+func NewMapPattern(keys []Expr, values []Pattern, pos SEPos) *MapPattern {
+	return &MapPattern{
+		Keys:   keys,
+		Values: values,
+		Pos:    pos,
+	}
+}
+
+func (mapPattern MapPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitMapPattern(mapPattern, value, env)
+}
+
+func (mapPattern MapPattern) ToString() string {
+	s := "{"
+	for index := range mapPattern.Keys {
+		s += fmt.Sprintf("%s: %s ", mapPattern.Keys[index].ToString(), mapPattern.Values[index].ToString())
+	}
+	s += "}"
+
+	return fmt.Sprintf("(MAP_PATTERN: %s)", s)
+}
+
+func (mapPattern MapPattern) GetPosition() SEPos {
+	return mapPattern.Pos
+}
+
+type ClassPattern struct {
+	Class    *VarAccessExpr
+	Fields   []Token
+	Patterns []Pattern
+	Pos      SEPos
+}
+
+func NewClassPattern(class *VarAccessExpr, fields []Token, patterns []Pattern, pos SEPos) *ClassPattern {
+	return &ClassPattern{
+		Class:    class,
+		Fields:   fields,
+		Patterns: patterns,
+		Pos:      pos,
+	}
+}
+
+func (classPattern ClassPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitClassPattern(classPattern, value, env)
+}
+
+func (classPattern ClassPattern) ToString() string {
+	s := "("
+	for index := range classPattern.Fields {
+		s += fmt.Sprintf("%s: %s ", classPattern.Fields[index].Value, classPattern.Patterns[index].ToString())
+	}
+	s += ")"
+
+	return fmt.Sprintf("(CLASS_PATTERN: %s %s)", classPattern.Class.ToString(), s)
+}
+
+func (classPattern ClassPattern) GetPosition() SEPos {
+	return classPattern.Pos
+}
+
+type DefaultPattern struct {
+	Pattern Pattern
+	Default Expr
+	Pos     SEPos
+}
+
+func NewDefaultPattern(pattern Pattern, def Expr, pos SEPos) *DefaultPattern {
+	return &DefaultPattern{
+		Pattern: pattern,
+		Default: def,
+		Pos:     pos,
+	}
+}
+
+func (defaultPattern DefaultPattern) Accept(visitor PatternVisitor, value RTValue, env *Environment) (bool, error) {
+	return visitor.VisitDefaultPattern(defaultPattern, value, env)
+}
+
+func (defaultPattern DefaultPattern) ToString() string {
+	return fmt.Sprintf("(DEFAULT_PATTERN: %s = %s)", defaultPattern.Pattern.ToString(), defaultPattern.Default.ToString())
+}
+
+func (defaultPattern DefaultPattern) GetPosition() SEPos {
+	return defaultPattern.Pos
+}
+
+func patternNames(pattern Pattern) []Token {
+	names := make([]Token, 0)
+
+	switch pattern := pattern.(type) {
+	case *BindingPattern:
+		names = append(names, *NewToken(IDENTIFIER, pattern.Name, pattern.Pos))
+	case *ListPattern:
+		for _, element := range pattern.Before {
+			names = append(names, patternNames(element)...)
+		}
+
+		if pattern.Rest != nil && pattern.Rest.Value != "_" {
+			names = append(names, *pattern.Rest)
+		}
+
+		for _, element := range pattern.After {
+			names = append(names, patternNames(element)...)
+		}
+	case *TuplePattern:
+		for _, element := range pattern.Elements {
+			names = append(names, patternNames(element)...)
+		}
+	case *MapPattern:
+		for _, value := range pattern.Values {
+			names = append(names, patternNames(value)...)
+		}
+	case *ClassPattern:
+		for _, field := range pattern.Patterns {
+			names = append(names, patternNames(field)...)
+		}
+	case *DefaultPattern:
+		names = append(names, patternNames(pattern.Pattern)...)
+	}
+
+	return names
+}
@@ -0,0 +1,295 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type RTRange struct {
+	Pos         SEPos
+	Start       int
+	End         int
+	Step        int
+	Environment *Environment
+}
+
+func NewRTRange(pos SEPos, start int, end int, step int, env *Environment) *RTRange {
+	return &RTRange{
+		Pos:         pos,
+		Start:       start,
+		End:         end,
+		Step:        step,
+		Environment: env,
+	}
+}
+
+func (rTRange *RTRange) Len() int {
+	if rTRange.Step > 0 && rTRange.Start < rTRange.End {
+		return (rTRange.End - rTRange.Start + rTRange.Step - 1) / rTRange.Step
+	} else if rTRange.Step < 0 && rTRange.Start > rTRange.End {
+		return (rTRange.Start - rTRange.End - rTRange.Step - 1) / -rTRange.Step
+	}
+
+	return 0
+}
+
+func (rTRange *RTRange) ToString() string {
+	return fmt.Sprintf("(RANGE: %d %d %d)", rTRange.Start, rTRange.End, rTRange.Step)
+}
+
+func (rTRange *RTRange) ValueToString() string {
+	return fmt.Sprintf("range(%d, %d, %d)", rTRange.Start, rTRange.End, rTRange.Step)
+}
+
+func (rTRange *RTRange) GetType() RTType {
+	return RTT_RANGE
+}
+
+func (rTRange *RTRange) GetValue() interface{} {
+	return []int{rTRange.Start, rTRange.End, rTRange.Step}
+}
+
+func (rTRange *RTRange) GetEnvironment() *Environment {
+	return rTRange.Environment
+}
+
+func (rTRange *RTRange) Dot(other Token, position SEPos) (RTValue, error) {
+	return nil, NewInvalidAttributeRTError(rTRange, other, position, rTRange.Environment)
+}
+
+func (rTRange *RTRange) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTRange, other, value, position, rTRange.Environment)
+}
+
+func (rTRange *RTRange) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTRange, position, rTRange.Environment)
+}
+
+func (rTRange *RTRange) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTRange, position, rTRange.Environment)
+}
+
+func (rTRange *RTRange) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTRange, position, rTRange.Environment)
+}
+
+func (rTRange *RTRange) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTRange, position, rTRange.Environment)
+}
+
+func (rTRange *RTRange) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	index := 0
+	length := rTRange.Len()
+
+	return func() (RTValue, bool, error) {
+		if index >= length {
+			return nil, false, nil
+		}
+
+		value := NewRTInt(position, rTRange.Start+index*rTRange.Step, rTRange.Environment)
+		index++
+
+		return value, true, nil
+	}, nil
+}
+
+func (rTRange *RTRange) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Equals(other RTValue, position SEPos) (RTValue, error) {
+	if other.GetType() != RTT_RANGE {
+		return NewRTBool(position, false, rTRange.Environment), nil
+	}
+
+	otherRange := other.(*RTRange)
+	equal := otherRange.Start == rTRange.Start && otherRange.End == rTRange.End && otherRange.Step == rTRange.Step
+
+	return NewRTBool(position, equal, rTRange.Environment), nil
+}
+
+func (rTRange *RTRange) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTRange.Equals(other, position)
+	if err != nil {
+		return nil, err
+	}
+
+	return equal.Not(position)
+}
+
+func (rTRange *RTRange) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTRange,
+		other,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTRange.Len() == 0, rTRange.Environment), nil
+}
+
+func (rTRange *RTRange) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTRange,
+		nil,
+		position,
+		rTRange.Environment,
+	)
+}
+
+func (rTRange *RTRange) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTRange.Len() != 0, rTRange.Environment), nil
+}
+
+func (rTRange *RTRange) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTRange, position, rTRange.Environment)
+}
@@ -1,5 +1,13 @@
 package snow
 
+type RTIterator func() (RTValue, bool, error)
+
+type NamedArgument struct {
+	Name  string
+	Value RTValue
+	Pos   SEPos
+}
+
 type RTValue interface {
 	ToString() string
 	ValueToString() string
@@ -8,10 +16,23 @@ type RTValue interface {
 	GetEnvironment() *Environment
 	Dot(other Token, position SEPos) (RTValue, error)
 	SetAttribute(other string, value RTValue, position SEPos) (RTValue, error)
+	Index(index RTValue, position SEPos) (RTValue, error)
+	SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error)
+	Slice(start RTValue, end RTValue, position SEPos) (RTValue, error)
+	Hash(position SEPos) (string, error)
+	Iter(position SEPos, interpreter *Interpreter) (RTIterator, error)
 	Add(other RTValue, position SEPos) (RTValue, error)
 	Subtract(other RTValue, position SEPos) (RTValue, error)
 	Multiply(other RTValue, position SEPos) (RTValue, error)
 	Divide(other RTValue, position SEPos) (RTValue, error)
+	Modulo(other RTValue, position SEPos) (RTValue, error)
+	Power(other RTValue, position SEPos) (RTValue, error)
+	FloorDivide(other RTValue, position SEPos) (RTValue, error)
+	BitwiseAnd(other RTValue, position SEPos) (RTValue, error)
+	BitwiseOr(other RTValue, position SEPos) (RTValue, error)
+	BitwiseXor(other RTValue, position SEPos) (RTValue, error)
+	LeftShift(other RTValue, position SEPos) (RTValue, error)
+	RightShift(other RTValue, position SEPos) (RTValue, error)
 	Equals(other RTValue, position SEPos) (RTValue, error)
 	NotEquals(other RTValue, position SEPos) (RTValue, error)
 	GreaterThan(other RTValue, position SEPos) (RTValue, error)
@@ -19,6 +40,7 @@ type RTValue interface {
 	LessThan(other RTValue, position SEPos) (RTValue, error)
 	LessThanEquals(other RTValue, position SEPos) (RTValue, error)
 	Not(position SEPos) (RTValue, error)
+	BitwiseNot(position SEPos) (RTValue, error)
 	ToBool(SEPos) (RTValue, error)
-	Call(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error)
+	Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error)
 }
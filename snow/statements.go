@@ -15,12 +15,20 @@ type StmtVisitor interface {
 	VisitVarDeclStmt(stmt VarDeclStmt, env *Environment) (RTValue, error)
 	VisitBlockStmt(stmt BlockStmt, env *Environment, newEnv bool) (RTValue, error)
 	VisitWhileStmt(stmt WhileStmt, env *Environment) (RTValue, error)
+	VisitForStmt(stmt ForStmt, env *Environment) (RTValue, error)
 	VisitBreakStmt(stmt BreakStmt, env *Environment) (RTValue, error)
 	VisitContinueStmt(stmt ContinueStmt, env *Environment) (RTValue, error)
 	VisitFunctionDeclStmt(stmt FunctionDeclStmt, env *Environment) (RTValue, error)
 	VisitReturnStmt(stmt ReturnStmt, env *Environment) (RTValue, error)
 	VisitIfStmt(stmt IfStmt, env *Environment) (RTValue, error)
 	VisitIfStmtContainer(stmt IfStmtContainer, env *Environment) (RTValue, error)
+	VisitClassDeclStmt(stmt ClassDeclStmt, env *Environment) (RTValue, error)
+	VisitMatchStmt(stmt MatchStmt, env *Environment) (RTValue, error)
+	VisitThrowStmt(stmt ThrowStmt, env *Environment) (RTValue, error)
+	VisitTryStmt(stmt TryStmt, env *Environment) (RTValue, error)
+	VisitImportStmt(stmt ImportStmt, env *Environment) (RTValue, error)
+	VisitYieldStmt(stmt YieldStmt, env *Environment) (RTValue, error)
+	VisitSelectStmt(stmt SelectStmt, env *Environment) (RTValue, error)
 }
 
 type ExpressionStmt struct {
@@ -50,14 +58,26 @@ func (expressionStmt ExpressionStmt) GetPos() SEPos {
 type VarDeclStmt struct {
 	VarType    Token
 	Identifier Token
+	Pattern    Pattern
+	Type       *TypeAnnotation
 	Expression Expr
 	Pos        SEPos
 }
 
-func NewVarDeclStmt(varType Token, identifier Token, expression Expr, pos SEPos) *VarDeclStmt {
+func NewVarDeclStmt(varType Token, identifier Token, typeAnnotation *TypeAnnotation, expression Expr, pos SEPos) *VarDeclStmt {
 	return &VarDeclStmt{
 		VarType:    varType,
 		Identifier: identifier,
+		Type:       typeAnnotation,
+		Expression: expression,
+		Pos:        pos,
+	}
+}
+
+func NewDestructuringVarDeclStmt(varType Token, pattern Pattern, expression Expr, pos SEPos) *VarDeclStmt {
+	return &VarDeclStmt{
+		VarType:    varType,
+		Pattern:    pattern,
 		Expression: expression,
 		Pos:        pos,
 	}
@@ -68,6 +88,14 @@ func (varDeclStmt VarDeclStmt) Accept(visitor StmtVisitor, env *Environment) (RT
 }
 
 func (varDeclStmt VarDeclStmt) ToString() string {
+	if varDeclStmt.Pattern != nil {
+		return fmt.Sprintf("(VAR_DECL_STMT: %s %s = %s)", varDeclStmt.VarType.Value, varDeclStmt.Pattern.ToString(), varDeclStmt.Expression.ToString())
+	}
+
+	if varDeclStmt.Type != nil {
+		return fmt.Sprintf("(VAR_DECL_STMT: %s %s %s = %s)", varDeclStmt.VarType.Value, varDeclStmt.Identifier.ToString(), varDeclStmt.Type.ToString(), varDeclStmt.Expression.ToString())
+	}
+
 	return fmt.Sprintf("(VAR_DECL_STMT: %s %s = %s)", varDeclStmt.VarType.Value, varDeclStmt.Identifier.ToString(), varDeclStmt.Expression.ToString())
 }
 
@@ -108,13 +136,15 @@ func (blockStmt BlockStmt) GetPos() SEPos {
 }
 
 type WhileStmt struct {
+	Label      *Token
 	Statement  Stmt
 	Expression Expr
 	Pos        SEPos
 }
 
-func NewWhileStmt(statement Stmt, expression Expr, pos SEPos) *WhileStmt {
+func NewWhileStmt(label *Token, statement Stmt, expression Expr, pos SEPos) *WhileStmt {
 	return &WhileStmt{
+		Label:      label,
 		Statement:  statement,
 		Expression: expression,
 		Pos:        pos,
@@ -126,6 +156,10 @@ func (whileStmt WhileStmt) Accept(visitor StmtVisitor, env *Environment) (RTValu
 }
 
 func (whileStmt WhileStmt) ToString() string {
+	if whileStmt.Label != nil {
+		return fmt.Sprintf("(WHILE_STMT: %s: %s %s)", whileStmt.Label.Value, whileStmt.Expression.ToString(), whileStmt.Statement.ToString())
+	}
+
 	return fmt.Sprintf("(WHILE_STMT: %s %s)", whileStmt.Expression.ToString(), whileStmt.Statement.ToString())
 }
 
@@ -133,13 +167,49 @@ func (whileStmt WhileStmt) GetPos() SEPos {
 	return whileStmt.Pos
 }
 
+type ForStmt struct {
+	Label      *Token
+	Identifier Token
+	Iterable   Expr
+	Statement  Stmt
+	Pos        SEPos
+}
+
+func NewForStmt(label *Token, identifier Token, iterable Expr, statement Stmt, pos SEPos) *ForStmt {
+	return &ForStmt{
+		Label:      label,
+		Identifier: identifier,
+		Iterable:   iterable,
+		Statement:  statement,
+		Pos:        pos,
+	}
+}
+
+func (forStmt ForStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitForStmt(forStmt, env)
+}
+
+func (forStmt ForStmt) ToString() string {
+	if forStmt.Label != nil {
+		return fmt.Sprintf("(FOR_STMT: %s: %s %s %s)", forStmt.Label.Value, forStmt.Identifier.ToString(), forStmt.Iterable.ToString(), forStmt.Statement.ToString())
+	}
+
+	return fmt.Sprintf("(FOR_STMT: %s %s %s)", forStmt.Identifier.ToString(), forStmt.Iterable.ToString(), forStmt.Statement.ToString())
+}
+
+func (forStmt ForStmt) GetPos() SEPos {
+	return forStmt.Pos
+}
+
 type BreakStmt struct {
-	Pos SEPos
+	Label *Token
+	Pos   SEPos
 }
 
-func NewBreakStmt(pos SEPos) *BreakStmt {
+func NewBreakStmt(label *Token, pos SEPos) *BreakStmt {
 	return &BreakStmt{
-		Pos: pos,
+		Label: label,
+		Pos:   pos,
 	}
 }
 
@@ -148,6 +218,10 @@ func (breakStmt BreakStmt) Accept(visitor StmtVisitor, env *Environment) (RTValu
 }
 
 func (breakStmt BreakStmt) ToString() string {
+	if breakStmt.Label != nil {
+		return fmt.Sprintf("(BREAK_STMT: %s)", breakStmt.Label.Value)
+	}
+
 	return "(BREAK_STMT)"
 }
 
@@ -156,12 +230,14 @@ func (breakStmt BreakStmt) GetPos() SEPos {
 }
 
 type ContinueStmt struct {
-	Pos SEPos
+	Label *Token
+	Pos   SEPos
 }
 
-func NewContinueStmt(pos SEPos) *ContinueStmt {
+func NewContinueStmt(label *Token, pos SEPos) *ContinueStmt {
 	return &ContinueStmt{
-		Pos: pos,
+		Label: label,
+		Pos:   pos,
 	}
 }
 
@@ -170,6 +246,10 @@ func (continueStmt ContinueStmt) Accept(visitor StmtVisitor, env *Environment) (
 }
 
 func (continueStmt ContinueStmt) ToString() string {
+	if continueStmt.Label != nil {
+		return fmt.Sprintf("(CONTINUE_STMT: %s)", continueStmt.Label.Value)
+	}
+
 	return "(CONTINUE_STMT)"
 }
 
@@ -177,18 +257,53 @@ func (continueStmt ContinueStmt) GetPos() SEPos {
 	return continueStmt.Pos
 }
 
+type Parameter struct {
+	Name     Token
+	Type     *TypeAnnotation
+	Default  Expr
+	Variadic bool
+}
+
+func NewParameter(name Token, typeAnnotation *TypeAnnotation, def Expr, variadic bool) *Parameter {
+	return &Parameter{
+		Name:     name,
+		Type:     typeAnnotation,
+		Default:  def,
+		Variadic: variadic,
+	}
+}
+
+func (parameter Parameter) ToString() string {
+	name := parameter.Name.Value
+	if parameter.Type != nil {
+		name += " " + parameter.Type.ToString()
+	}
+
+	if parameter.Variadic {
+		return fmt.Sprintf("(PARAMETER: ...%s)", name)
+	} else if parameter.Default != nil {
+		return fmt.Sprintf("(PARAMETER: %s = %s)", name, parameter.Default.ToString())
+	}
+
+	return fmt.Sprintf("(PARAMETER: %s)", name)
+}
+
 type FunctionDeclStmt struct {
 	Name       string
-	Parameters []Token
+	Parameters []Parameter
+	ReturnType *TypeAnnotation
 	Block      *BlockStmt
+	Generator  bool
 	Pos        SEPos
 }
 
-func NewFunctionDeclStmt(name string, parameters []Token, block *BlockStmt, pos SEPos) *FunctionDeclStmt {
+func NewFunctionDeclStmt(name string, parameters []Parameter, returnType *TypeAnnotation, block *BlockStmt, generator bool, pos SEPos) *FunctionDeclStmt {
 	return &FunctionDeclStmt{
 		Name:       name,
 		Parameters: parameters,
+		ReturnType: returnType,
 		Block:      block,
+		Generator:  generator,
 		Pos:        pos,
 	}
 }
@@ -204,6 +319,10 @@ func (functionDeclStmt FunctionDeclStmt) ToString() string {
 	}
 	p += "]"
 
+	if functionDeclStmt.ReturnType != nil {
+		return fmt.Sprintf("(FUNCTION_DECL_STMT: %s %s %s %s)", functionDeclStmt.Name, p, functionDeclStmt.ReturnType.ToString(), functionDeclStmt.Block.ToString())
+	}
+
 	return fmt.Sprintf("(FUNCTION_DECL_STMT: %s %s %s)", functionDeclStmt.Name, p, functionDeclStmt.Block.ToString())
 }
 
@@ -284,3 +403,331 @@ func (ifStmtContainer IfStmtContainer) ToString() string {
 func (ifStmtContainer IfStmtContainer) GetPos() SEPos {
 	return ifStmtContainer.Pos
 }
+
+type ClassDeclStmt struct {
+	Name       string
+	SuperClass *VarAccessExpr
+	Methods    []*FunctionDeclStmt
+	Pos        SEPos
+}
+
+func NewClassDeclStmt(name string, superClass *VarAccessExpr, methods []*FunctionDeclStmt, pos SEPos) *ClassDeclStmt {
+	return &ClassDeclStmt{
+		Name:       name,
+		SuperClass: superClass,
+		Methods:    methods,
+		Pos:        pos,
+	}
+}
+
+func (classDeclStmt ClassDeclStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitClassDeclStmt(classDeclStmt, env)
+}
+
+func (classDeclStmt ClassDeclStmt) ToString() string {
+	m := "["
+	for _, method := range classDeclStmt.Methods {
+		m += method.ToString() + " "
+	}
+	m += "]"
+
+	superClass := ""
+	if classDeclStmt.SuperClass != nil {
+		superClass = classDeclStmt.SuperClass.ToString()
+	}
+
+	return fmt.Sprintf("(CLASS_DECL_STMT: %s %s %s)", classDeclStmt.Name, superClass, m)
+}
+
+func (classDeclStmt ClassDeclStmt) GetPos() SEPos {
+	return classDeclStmt.Pos
+}
+
+type MatchCase struct {
+	Patterns  []Pattern
+	Guard     Expr
+	Statement Stmt
+	Pos       SEPos
+}
+
+func NewMatchCase(patterns []Pattern, guard Expr, statement Stmt, pos SEPos) *MatchCase {
+	return &MatchCase{
+		Patterns:  patterns,
+		Guard:     guard,
+		Statement: statement,
+		Pos:       pos,
+	}
+}
+
+func (matchCase MatchCase) ToString() string {
+	p := "["
+	for _, pattern := range matchCase.Patterns {
+		p += pattern.ToString() + " "
+	}
+	p += "]"
+
+	guard := ""
+	if matchCase.Guard != nil {
+		guard = " IF " + matchCase.Guard.ToString()
+	}
+
+	return fmt.Sprintf("(CASE: %s%s %s)", p, guard, matchCase.Statement.ToString())
+}
+
+type MatchStmt struct {
+	Value Expr
+	Cases []MatchCase
+	Pos   SEPos
+}
+
+func NewMatchStmt(value Expr, cases []MatchCase, pos SEPos) *MatchStmt {
+	return &MatchStmt{
+		Value: value,
+		Cases: cases,
+		Pos:   pos,
+	}
+}
+
+func (matchStmt MatchStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitMatchStmt(matchStmt, env)
+}
+
+func (matchStmt MatchStmt) ToString() string {
+	c := "["
+	for _, matchCase := range matchStmt.Cases {
+		c += matchCase.ToString() + " "
+	}
+	c += "]"
+
+	return fmt.Sprintf("(MATCH_STMT: %s %s)", matchStmt.Value.ToString(), c)
+}
+
+func (matchStmt MatchStmt) GetPos() SEPos {
+	return matchStmt.Pos
+}
+
+type ThrowStmt struct {
+	Value Expr
+	Pos   SEPos
+}
+
+func NewThrowStmt(value Expr, pos SEPos) *ThrowStmt {
+	return &ThrowStmt{
+		Value: value,
+		Pos:   pos,
+	}
+}
+
+func (throwStmt ThrowStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitThrowStmt(throwStmt, env)
+}
+
+func (throwStmt ThrowStmt) ToString() string {
+	return fmt.Sprintf("(THROW_STMT: %s)", throwStmt.Value.ToString())
+}
+
+func (throwStmt ThrowStmt) GetPos() SEPos {
+	return throwStmt.Pos
+}
+
+type CatchClause struct {
+	Name      *Token
+	Types     []Expr
+	Statement Stmt
+	Pos       SEPos
+}
+
+func NewCatchClause(name *Token, types []Expr, statement Stmt, pos SEPos) *CatchClause {
+	return &CatchClause{
+		Name:      name,
+		Types:     types,
+		Statement: statement,
+		Pos:       pos,
+	}
+}
+
+func (catchClause CatchClause) ToString() string {
+	name := ""
+	if catchClause.Name != nil {
+		name = catchClause.Name.Value
+	}
+
+	t := "["
+	for _, catchType := range catchClause.Types {
+		t += catchType.ToString() + " "
+	}
+	t += "]"
+
+	return fmt.Sprintf("(CATCH: %s %s %s)", name, t, catchClause.Statement.ToString())
+}
+
+type TryStmt struct {
+	Statement Stmt
+	Catches   []CatchClause
+	Finally   Stmt
+	Pos       SEPos
+}
+
+func NewTryStmt(statement Stmt, catches []CatchClause, finally Stmt, pos SEPos) *TryStmt {
+	return &TryStmt{
+		Statement: statement,
+		Catches:   catches,
+		Finally:   finally,
+		Pos:       pos,
+	}
+}
+
+func (tryStmt TryStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitTryStmt(tryStmt, env)
+}
+
+func (tryStmt TryStmt) ToString() string {
+	c := "["
+	for _, catchClause := range tryStmt.Catches {
+		c += catchClause.ToString() + " "
+	}
+	c += "]"
+
+	finally := ""
+	if tryStmt.Finally != nil {
+		finally = " FINALLY " + tryStmt.Finally.ToString()
+	}
+
+	return fmt.Sprintf("(TRY_STMT: %s %s%s)", tryStmt.Statement.ToString(), c, finally)
+}
+
+func (tryStmt TryStmt) GetPos() SEPos {
+	return tryStmt.Pos
+}
+
+type ImportStmt struct {
+	Path  Token
+	Alias Token
+	Names []Token
+	Pos   SEPos
+}
+
+func NewImportStmt(path Token, alias Token, names []Token, pos SEPos) *ImportStmt {
+	return &ImportStmt{
+		Path:  path,
+		Alias: alias,
+		Names: names,
+		Pos:   pos,
+	}
+}
+
+func (importStmt ImportStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitImportStmt(importStmt, env)
+}
+
+func (importStmt ImportStmt) ToString() string {
+	if importStmt.Names != nil {
+		n := "["
+		for _, name := range importStmt.Names {
+			n += name.Value + " "
+		}
+		n += "]"
+
+		return fmt.Sprintf("(IMPORT_STMT: %s %s)", importStmt.Path.Value, n)
+	}
+
+	return fmt.Sprintf("(IMPORT_STMT: %s AS %s)", importStmt.Path.Value, importStmt.Alias.Value)
+}
+
+func (importStmt ImportStmt) GetPos() SEPos {
+	return importStmt.Pos
+}
+
+type YieldStmt struct {
+	Value Expr
+	Pos   SEPos
+}
+
+func NewYieldStmt(value Expr, pos SEPos) *YieldStmt {
+	return &YieldStmt{
+		Value: value,
+		Pos:   pos,
+	}
+}
+
+func (yieldStmt YieldStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitYieldStmt(yieldStmt, env)
+}
+
+func (yieldStmt YieldStmt) ToString() string {
+	if yieldStmt.Value == nil {
+		return "(YIELD_STMT)"
+	}
+
+	return fmt.Sprintf("(YIELD_STMT: %s)", yieldStmt.Value.ToString())
+}
+
+func (yieldStmt YieldStmt) GetPos() SEPos {
+	return yieldStmt.Pos
+}
+
+type SelectCase struct {
+	Name      *Token
+	Channel   Expr
+	Send      bool
+	Value     Expr
+	Statement Stmt
+	Pos       SEPos
+}
+
+func NewSelectCase(name *Token, channel Expr, send bool, value Expr, statement Stmt, pos SEPos) *SelectCase {
+	return &SelectCase{
+		Name:      name,
+		Channel:   channel,
+		Send:      send,
+		Value:     value,
+		Statement: statement,
+		Pos:       pos,
+	}
+}
+
+func (selectCase SelectCase) ToString() string {
+	if selectCase.Send {
+		return fmt.Sprintf("(CASE: %s SEND %s %s)", selectCase.Channel.ToString(), selectCase.Value.ToString(), selectCase.Statement.ToString())
+	} else if selectCase.Name != nil {
+		return fmt.Sprintf("(CASE: %s = %s RECV %s)", selectCase.Name.Value, selectCase.Channel.ToString(), selectCase.Statement.ToString())
+	}
+
+	return fmt.Sprintf("(CASE: %s RECV %s)", selectCase.Channel.ToString(), selectCase.Statement.ToString())
+}
+
+type SelectStmt struct {
+	Cases []SelectCase
+	Else  Stmt
+	Pos   SEPos
+}
+
+func NewSelectStmt(cases []SelectCase, elseStmt Stmt, pos SEPos) *SelectStmt {
+	return &SelectStmt{
+		Cases: cases,
+		Else:  elseStmt,
+		Pos:   pos,
+	}
+}
+
+func (selectStmt SelectStmt) Accept(visitor StmtVisitor, env *Environment) (RTValue, error) {
+	return visitor.VisitSelectStmt(selectStmt, env)
+}
+
+func (selectStmt SelectStmt) ToString() string {
+	c := "["
+	for _, selectCase := range selectStmt.Cases {
+		c += selectCase.ToString() + " "
+	}
+	c += "]"
+
+	if selectStmt.Else != nil {
+		return fmt.Sprintf("(SELECT_STMT: %s ELSE %s)", c, selectStmt.Else.ToString())
+	}
+
+	return fmt.Sprintf("(SELECT_STMT: %s)", c)
+}
+
+func (selectStmt SelectStmt) GetPos() SEPos {
+	return selectStmt.Pos
+}
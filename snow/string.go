@@ -0,0 +1,352 @@
+package snow
+
+import (
+	"fmt"
+	"strings"
+)
+
+const MaxStringLength = 1 << 30
+
+type RTString struct {
+	Pos         SEPos
+	Value       string
+	Environment *Environment
+}
+
+func NewRTString(pos SEPos, value string, env *Environment) *RTString {
+	return &RTString{
+		Pos:         pos,
+		Value:       value,
+		Environment: env,
+	}
+}
+
+func (rTString *RTString) ToString() string {
+	return fmt.Sprintf("(STRING: \"%s\")", rTString.Value)
+}
+
+func (rTString *RTString) ValueToString() string {
+	return rTString.Value
+}
+
+func (rTString *RTString) GetType() RTType {
+	return RTT_STRING
+}
+
+func (rTString *RTString) GetValue() interface{} {
+	return rTString.Value
+}
+
+func (rTString *RTString) GetEnvironment() *Environment {
+	return rTString.Environment
+}
+
+func (rTString *RTString) Dot(other Token, position SEPos) (RTValue, error) {
+	return nil, NewInvalidAttributeRTError(rTString, other, position, rTString.Environment)
+}
+
+func (rTString *RTString) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTString, other, value, position, rTString.Environment)
+}
+
+func (rTString *RTString) Index(index RTValue, position SEPos) (RTValue, error) {
+	if index.GetType() != RTT_INT {
+		return nil, NewInvalidIndexRTError(rTString, index, position, rTString.Environment)
+	}
+
+	runes := []rune(rTString.Value)
+
+	idx, err := toInt(index, position, rTString.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := normalizeIndex(idx, len(runes))
+	if !ok {
+		return nil, NewIndexOutOfRangeRTError(rTString, idx, len(runes), position, rTString.Environment)
+	}
+
+	return NewRTString(position, string(runes[i]), rTString.Environment), nil
+}
+
+func (rTString *RTString) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTString, "index "+index.ValueToString(), value, position, rTString.Environment)
+}
+
+func (rTString *RTString) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	runes := []rune(rTString.Value)
+
+	startIdx, endIdx, err := sliceBounds(rTString, start, end, len(runes), position)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTString(position, string(runes[startIdx:endIdx]), rTString.Environment), nil
+}
+
+func (rTString *RTString) Hash(position SEPos) (string, error) {
+	return "s:" + rTString.Value, nil
+}
+
+func (rTString *RTString) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	runes := []rune(rTString.Value)
+	index := 0
+
+	return func() (RTValue, bool, error) {
+		if index >= len(runes) {
+			return nil, false, nil
+		}
+
+		value := NewRTString(position, string(runes[index]), rTString.Environment)
+		index++
+
+		return value, true, nil
+	}, nil
+}
+
+func (rTString *RTString) Add(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTString(position, rTString.Value+other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PLUS,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_INT:
+		return rTString.repeat(other, position)
+	}
+
+	return nil, NewValueRTError(
+		STAR,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Equals(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTBool(position, rTString.Value == other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return NewRTBool(position, false, rTString.Environment), nil
+}
+
+func (rTString *RTString) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTBool(position, rTString.Value != other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return NewRTBool(position, true, rTString.Environment), nil
+}
+
+func (rTString *RTString) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTBool(position, rTString.Value > other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTBool(position, rTString.Value >= other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTBool(position, rTString.Value < other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_STRING:
+		return NewRTBool(position, rTString.Value <= other.GetValue().(string), rTString.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTString,
+		other,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTString.Value == "", rTString.Environment), nil
+}
+
+func (rTString *RTString) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTString,
+		nil,
+		position,
+		rTString.Environment,
+	)
+}
+
+func (rTString *RTString) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, rTString.Value != "", rTString.Environment), nil
+}
+
+func (rTString *RTString) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTString, position, rTString.Environment)
+}
+
+func (rTString *RTString) repeat(other RTValue, position SEPos) (RTValue, error) {
+	count, err := toInt(other, position, rTString.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	if count <= 0 || len(rTString.Value) == 0 {
+		return NewRTString(position, "", rTString.Environment), nil
+	}
+
+	if count > MaxStringLength/len(rTString.Value) {
+		return nil, NewTooBigValueRTError(other, position, rTString.Environment)
+	}
+
+	return NewRTString(position, strings.Repeat(rTString.Value, count), rTString.Environment), nil
+}
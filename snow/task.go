@@ -0,0 +1,355 @@
+package snow
+
+import (
+	"fmt"
+)
+
+type RTTask struct {
+	Name        string
+	Pos         SEPos
+	Environment *Environment
+	done        bool
+	awaited     bool
+	waiters     []*channelWaiter
+	result      RTValue
+	err         error
+}
+
+func NewRTTask(name string, pos SEPos, env *Environment) *RTTask {
+	return &RTTask{
+		Name:        name,
+		Pos:         pos,
+		Environment: env,
+	}
+}
+
+func taskName(function RTValue) string {
+	switch function := function.(type) {
+	case *RTFunction:
+		return function.Name
+	case *RTBuiltinFunction:
+		return function.Name
+	}
+
+	return string(function.GetType())
+}
+
+func (rTTask *RTTask) run(function RTValue, arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter, env *Environment) {
+	taskInterpreter := NewInterpreter(nil, interpreter.file, env)
+	taskInterpreter.modules = interpreter.modules
+	taskInterpreter.importing = interpreter.importing
+	taskInterpreter.inFunc = 1
+	taskInterpreter.callerEnv = env
+
+	startTask()
+
+	go func() {
+		result, err := function.Call(arguments, namedArguments, position, taskInterpreter)
+
+		scheduler.mutex.Lock()
+		defer scheduler.mutex.Unlock()
+
+		rTTask.result, rTTask.err, rTTask.done = result, err, true
+		if err != nil {
+			scheduler.failed = append(scheduler.failed, rTTask)
+		}
+
+		for _, waiter := range rTTask.waiters {
+			waiter.fire(0, nil, true)
+		}
+		rTTask.waiters = nil
+
+		scheduler.active--
+		checkDeadlock()
+	}()
+}
+
+func (rTTask *RTTask) Await(position SEPos, env *Environment) (RTValue, error) {
+	scheduler.mutex.Lock()
+
+	rTTask.awaited = true
+
+	if !rTTask.done {
+		waiter := newChannelWaiter()
+		rTTask.waiters = append(rTTask.waiters, waiter)
+
+		err := waiter.wait(position, env)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduler.mutex.Lock()
+	}
+
+	defer scheduler.mutex.Unlock()
+
+	return rTTask.result, rTTask.err
+}
+
+func UnobservedTaskErrors() []error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	errors := make([]error, 0)
+	for _, task := range scheduler.failed {
+		if !task.awaited {
+			errors = append(errors, fmt.Errorf("the task '%s' failed and was never awaited\n%s", task.Name, task.err.Error()))
+		}
+	}
+
+	scheduler.failed = nil
+
+	return errors
+}
+
+func (rTTask *RTTask) Done() bool {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	return rTTask.done
+}
+
+func (rTTask *RTTask) ToString() string {
+	return fmt.Sprintf("(TASK: %s)", rTTask.Name)
+}
+
+func (rTTask *RTTask) ValueToString() string {
+	return fmt.Sprintf("TASK %s", rTTask.Name)
+}
+
+func (rTTask *RTTask) GetType() RTType {
+	return RTT_TASK
+}
+
+func (rTTask *RTTask) GetValue() interface{} {
+	return rTTask.result
+}
+
+func (rTTask *RTTask) GetEnvironment() *Environment {
+	return rTTask.Environment
+}
+
+func (rTTask *RTTask) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "done":
+		return NewRTBuiltinFunction("done", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			return NewRTBool(position, rTTask.Done(), interpreter.currentEnv()), nil
+		}, position, rTTask.Environment), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTTask, other, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTTask, other, value, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) Index(index RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTTask, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTTask, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	return nil, NewNotIndexableRTError(rTTask, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) Hash(position SEPos) (string, error) {
+	return "", NewUnhashableRTError(rTTask, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	return nil, NewNotIterableRTError(rTTask, position, rTTask.Environment)
+}
+
+func (rTTask *RTTask) Add(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PLUS,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Equals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other == RTValue(rTTask), rTTask.Environment), nil
+}
+
+func (rTTask *RTTask) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	return NewRTBool(position, other != RTValue(rTTask), rTTask.Environment), nil
+}
+
+func (rTTask *RTTask) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTTask,
+		other,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, false, rTTask.Environment), nil
+}
+
+func (rTTask *RTTask) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTTask,
+		nil,
+		position,
+		rTTask.Environment,
+	)
+}
+
+func (rTTask *RTTask) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, true, rTTask.Environment), nil
+}
+
+func (rTTask *RTTask) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTTask, position, rTTask.Environment)
+}
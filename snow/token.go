@@ -38,6 +38,10 @@ func (token *Token) ToString() string {
 		str = "{"
 	case RCURLYBRACKET:
 		str = "}"
+	case LBRACKET:
+		str = "["
+	case RBRACKET:
+		str = "]"
 	case EOF:
 		str = "(EOF)"
 	case SINGLE_EQUALS:
@@ -52,18 +56,60 @@ func (token *Token) ToString() string {
 		str = "<"
 	case LESS_THAN_EQUALS:
 		str = "<="
+	case ARROW:
+		str = "=>"
+	case PERCENT:
+		str = "%"
+	case DOUBLE_STAR:
+		str = "**"
+	case DOUBLE_SLASH:
+		str = "//"
+	case AMPERSAND:
+		str = "&"
+	case PIPE:
+		str = "|"
+	case CARET:
+		str = "^"
+	case TILDE:
+		str = "~"
+	case LEFT_SHIFT:
+		str = "<<"
+	case RIGHT_SHIFT:
+		str = ">>"
+	case PLUS_EQUALS:
+		str = "+="
+	case DASH_EQUALS:
+		str = "-="
+	case STAR_EQUALS:
+		str = "*="
+	case SLASH_EQUALS:
+		str = "/="
+	case PERCENT_EQUALS:
+		str = "%="
+	case DOUBLE_PLUS:
+		str = "++"
+	case DOUBLE_DASH:
+		str = "--"
+	case QUESTION:
+		str = "?"
+	case ELLIPSIS:
+		str = "..."
 	case NEWLINE:
 		str = "(NEWLINE)"
 	case DOT:
 		str = "."
 	case COMMA:
 		str = ","
+	case COLON:
+		str = ":"
 	case INT:
 		str = fmt.Sprintf("(INT: %s)", token.Value)
 	case FLOAT:
 		str = fmt.Sprintf("(FLOAT: %s)", token.Value)
 	case STRING:
 		str = fmt.Sprintf("(STRING: \"%s\")", token.Value)
+	case STRING_START, STRING_MIDDLE, STRING_END:
+		str = fmt.Sprintf("(%s: \"%s\")", token.TType, token.Value)
 	case IDENTIFIER:
 		str = fmt.Sprintf("(IDENTIFIER: %s)", token.Value)
 	default:
@@ -12,6 +12,8 @@ const (
 	RPAREN        TokenType = "RPAREN"
 	LCURLYBRACKET TokenType = "LCURLYBRACKET"
 	RCURLYBRACKET TokenType = "RCURLYBRACKET"
+	LBRACKET      TokenType = "LBRACKET"
+	RBRACKET      TokenType = "RBRACKET"
 
 	SINGLE_EQUALS       TokenType = "SINGLE_EQUALS"
 	EQUALS              TokenType = "EQUALS"
@@ -20,18 +22,58 @@ const (
 	GREATER_THAN_EQUALS TokenType = "GREATER_THAN_EQUALS"
 	LESS_THAN           TokenType = "LESS_THAN"
 	LESS_THAN_EQUALS    TokenType = "LESS_THAN_EQUALS"
+	ARROW               TokenType = "ARROW"
+	PERCENT             TokenType = "PERCENT"
+	DOUBLE_STAR         TokenType = "DOUBLE_STAR"
+	DOUBLE_SLASH        TokenType = "DOUBLE_SLASH"
+	AMPERSAND           TokenType = "AMPERSAND"
+	PIPE                TokenType = "PIPE"
+	CARET               TokenType = "CARET"
+	TILDE               TokenType = "TILDE"
+	LEFT_SHIFT          TokenType = "LEFT_SHIFT"
+	RIGHT_SHIFT         TokenType = "RIGHT_SHIFT"
+	PLUS_EQUALS         TokenType = "PLUS_EQUALS"
+	DASH_EQUALS         TokenType = "DASH_EQUALS"
+	STAR_EQUALS         TokenType = "STAR_EQUALS"
+	SLASH_EQUALS        TokenType = "SLASH_EQUALS"
+	PERCENT_EQUALS      TokenType = "PERCENT_EQUALS"
+	DOUBLE_PLUS         TokenType = "DOUBLE_PLUS"
+	DOUBLE_DASH         TokenType = "DOUBLE_DASH"
+	QUESTION            TokenType = "QUESTION"
+	ELLIPSIS            TokenType = "ELLIPSIS"
+	MATCH               TokenType = "MATCH"
+	CASE                TokenType = "CASE"
+	TRY                 TokenType = "TRY"
+	CATCH               TokenType = "CATCH"
+	FINALLY             TokenType = "FINALLY"
+	THROW               TokenType = "THROW"
+	IMPORT              TokenType = "IMPORT"
+	FROM                TokenType = "FROM"
+	AS                  TokenType = "AS"
+	YIELD               TokenType = "YIELD"
+	SPAWN               TokenType = "SPAWN"
+	AWAIT               TokenType = "AWAIT"
+	SELECT              TokenType = "SELECT"
 
-	INT        TokenType = "INT"
-	FLOAT      TokenType = "FLOAT"
-	STRING     TokenType = "STRING"
-	IDENTIFIER TokenType = "IDENTIFIER"
+	INT           TokenType = "INT"
+	FLOAT         TokenType = "FLOAT"
+	STRING        TokenType = "STRING"
+	STRING_START  TokenType = "STRING_START"
+	STRING_MIDDLE TokenType = "STRING_MIDDLE"
+	STRING_END    TokenType = "STRING_END"
+	IDENTIFIER    TokenType = "IDENTIFIER"
 
 	NOT      TokenType = "NOT"
+	AND      TokenType = "AND"
+	OR       TokenType = "OR"
 	TRUE     TokenType = "TRUE"
 	FALSE    TokenType = "FALSE"
+	NULL     TokenType = "NULL"
 	VAR      TokenType = "VAR"
 	CONST    TokenType = "CONST"
 	WHILE    TokenType = "WHILE"
+	FOR      TokenType = "FOR"
+	IN       TokenType = "IN"
 	CONTINUE TokenType = "CONTINUE"
 	BREAK    TokenType = "BREAK"
 	FUNCTION TokenType = "FUNCTION"
@@ -39,9 +81,13 @@ const (
 	IF       TokenType = "IF"
 	ELIF     TokenType = "ELIF"
 	ELSE     TokenType = "ELSE"
+	CLASS    TokenType = "CLASS"
+	THIS     TokenType = "THIS"
+	SUPER    TokenType = "SUPER"
 
 	DOT   TokenType = "DOT"
 	COMMA TokenType = "COMMA"
+	COLON TokenType = "COLON"
 
 	NEWLINE TokenType = "NEWLINE"
 
@@ -0,0 +1,381 @@
+package snow
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RTTuple struct {
+	Pos         SEPos
+	Values      []RTValue
+	Environment *Environment
+}
+
+func NewRTTuple(pos SEPos, values []RTValue, env *Environment) *RTTuple {
+	return &RTTuple{
+		Pos:         pos,
+		Values:      values,
+		Environment: env,
+	}
+}
+
+func (rTTuple *RTTuple) ToString() string {
+	s := "("
+	for _, v := range rTTuple.Values {
+		s += v.ToString() + " "
+	}
+	s += ")"
+
+	return fmt.Sprintf("(TUPLE: %s)", s)
+}
+
+func (rTTuple *RTTuple) ValueToString() string {
+	return rTTuple.valueToString(map[RTValue]bool{})
+}
+
+func (rTTuple *RTTuple) valueToString(visiting map[RTValue]bool) string {
+	if visiting[rTTuple] {
+		return "(...)"
+	}
+
+	visiting[rTTuple] = true
+	defer delete(visiting, rTTuple)
+
+	values := make([]string, 0, len(rTTuple.Values))
+	for _, v := range rTTuple.Values {
+		values = append(values, elementToString(v, visiting))
+	}
+
+	if len(values) == 1 {
+		return "(" + values[0] + ",)"
+	}
+
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func (rTTuple *RTTuple) GetType() RTType {
+	return RTT_TUPLE
+}
+
+func (rTTuple *RTTuple) GetValue() interface{} {
+	return rTTuple.Values
+}
+
+func (rTTuple *RTTuple) GetEnvironment() *Environment {
+	return rTTuple.Environment
+}
+
+func (rTTuple *RTTuple) Dot(other Token, position SEPos) (RTValue, error) {
+	switch other.Value {
+	case "len":
+		return NewRTBuiltinFunction("len", []string{}, func(arguments []RTValue, position SEPos, interpreter *Interpreter) (RTValue, error) {
+			return NewRTInt(position, len(rTTuple.Values), rTTuple.Environment), nil
+		}, position, rTTuple.Environment), nil
+	}
+
+	return nil, NewInvalidAttributeRTError(rTTuple, other, position, rTTuple.Environment)
+}
+
+func (rTTuple *RTTuple) SetAttribute(other string, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewUnableToAssignAttributeRTError(rTTuple, other, value, position, rTTuple.Environment)
+}
+
+func (rTTuple *RTTuple) Index(index RTValue, position SEPos) (RTValue, error) {
+	if index.GetType() != RTT_INT {
+		return nil, NewInvalidIndexRTError(rTTuple, index, position, rTTuple.Environment)
+	}
+
+	idx, err := toInt(index, position, rTTuple.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := normalizeIndex(idx, len(rTTuple.Values))
+	if !ok {
+		return nil, NewIndexOutOfRangeRTError(rTTuple, idx, len(rTTuple.Values), position, rTTuple.Environment)
+	}
+
+	return rTTuple.Values[i], nil
+}
+
+func (rTTuple *RTTuple) SetIndex(index RTValue, value RTValue, position SEPos) (RTValue, error) {
+	return nil, NewRuntimeError(
+		VALUE_ERROR,
+		fmt.Sprintf("unable to change the value at index '%s' of the tuple '%s'", index.ValueToString(), rTTuple.ValueToString()),
+		"Tuples can not be changed, create a new tuple instead",
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Slice(start RTValue, end RTValue, position SEPos) (RTValue, error) {
+	startIdx, endIdx, err := sliceBounds(rTTuple, start, end, len(rTTuple.Values), position)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]RTValue, endIdx-startIdx)
+	copy(values, rTTuple.Values[startIdx:endIdx])
+
+	return NewRTTuple(position, values, rTTuple.Environment), nil
+}
+
+func (rTTuple *RTTuple) Hash(position SEPos) (string, error) {
+	hash := "t:"
+	for _, v := range rTTuple.Values {
+		h, err := v.Hash(position)
+		if err != nil {
+			return "", err
+		}
+
+		hash += fmt.Sprintf("%d:%s", len(h), h)
+	}
+
+	return hash, nil
+}
+
+func (rTTuple *RTTuple) Iter(position SEPos, interpreter *Interpreter) (RTIterator, error) {
+	index := 0
+
+	return func() (RTValue, bool, error) {
+		if index >= len(rTTuple.Values) {
+			return nil, false, nil
+		}
+
+		value := rTTuple.Values[index]
+		index++
+
+		return value, true, nil
+	}, nil
+}
+
+func (rTTuple *RTTuple) Add(other RTValue, position SEPos) (RTValue, error) {
+	switch other.GetType() {
+	case RTT_TUPLE:
+		otherValues := other.GetValue().([]RTValue)
+
+		values := make([]RTValue, 0, len(rTTuple.Values)+len(otherValues))
+		values = append(values, rTTuple.Values...)
+		values = append(values, otherValues...)
+
+		return NewRTTuple(position, values, rTTuple.Environment), nil
+	}
+
+	return nil, NewValueRTError(
+		PLUS,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Subtract(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DASH,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Multiply(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		STAR,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Divide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		SLASH,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Modulo(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PERCENT,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Power(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_STAR,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) FloorDivide(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		DOUBLE_SLASH,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) BitwiseAnd(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		AMPERSAND,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) BitwiseOr(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		PIPE,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) BitwiseXor(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		CARET,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) LeftShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LEFT_SHIFT,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) RightShift(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		RIGHT_SHIFT,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Equals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTTuple.equals(other, position, map[containerPair]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTBool(position, equal, rTTuple.Environment), nil
+}
+
+func (rTTuple *RTTuple) NotEquals(other RTValue, position SEPos) (RTValue, error) {
+	equal, err := rTTuple.equals(other, position, map[containerPair]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRTBool(position, !equal, rTTuple.Environment), nil
+}
+
+func (rTTuple *RTTuple) equals(other RTValue, position SEPos, visiting map[containerPair]bool) (bool, error) {
+	if other.GetType() != RTT_TUPLE {
+		return false, nil
+	}
+
+	if other == RTValue(rTTuple) {
+		return true, nil
+	}
+
+	pair := containerPair{rTTuple, other}
+	if visiting[pair] {
+		return true, nil
+	}
+
+	visiting[pair] = true
+	defer delete(visiting, pair)
+
+	return valuesEqual(rTTuple.Values, other.GetValue().([]RTValue), position, visiting)
+}
+
+func (rTTuple *RTTuple) GreaterThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) GreaterThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		GREATER_THAN_EQUALS,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) LessThan(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) LessThanEquals(other RTValue, position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		LESS_THAN_EQUALS,
+		rTTuple,
+		other,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) Not(position SEPos) (RTValue, error) {
+	return NewRTBool(position, len(rTTuple.Values) == 0, rTTuple.Environment), nil
+}
+
+func (rTTuple *RTTuple) BitwiseNot(position SEPos) (RTValue, error) {
+	return nil, NewValueRTError(
+		TILDE,
+		rTTuple,
+		nil,
+		position,
+		rTTuple.Environment,
+	)
+}
+
+func (rTTuple *RTTuple) ToBool(position SEPos) (RTValue, error) {
+	return NewRTBool(position, len(rTTuple.Values) != 0, rTTuple.Environment), nil
+}
+
+func (rTTuple *RTTuple) Call(arguments []RTValue, namedArguments []NamedArgument, position SEPos, interpreter *Interpreter) (RTValue, error) {
+	return nil, NewInvalidCallRTError(rTTuple, position, rTTuple.Environment)
+}
@@ -3,8 +3,22 @@ package snow
 type RTType string
 
 const (
-	RTT_INT      RTType = "INT"
-	RTT_FLOAT    RTType = "FLOAT"
-	RTT_BOOL     RTType = "BOOL"
-	RTT_FUNCTION RTType = "FUNCTION"
+	RTT_INT              RTType = "INT"
+	RTT_FLOAT            RTType = "FLOAT"
+	RTT_BOOL             RTType = "BOOL"
+	RTT_STRING           RTType = "STRING"
+	RTT_NULL             RTType = "NULL"
+	RTT_LIST             RTType = "LIST"
+	RTT_TUPLE            RTType = "TUPLE"
+	RTT_MAP              RTType = "MAP"
+	RTT_RANGE            RTType = "RANGE"
+	RTT_FUNCTION         RTType = "FUNCTION"
+	RTT_BUILTIN_FUNCTION RTType = "BUILTIN_FUNCTION"
+	RTT_CLASS            RTType = "CLASS"
+	RTT_INSTANCE         RTType = "INSTANCE"
+	RTT_ERROR            RTType = "ERROR"
+	RTT_MODULE           RTType = "MODULE"
+	RTT_GENERATOR        RTType = "GENERATOR"
+	RTT_TASK             RTType = "TASK"
+	RTT_CHANNEL          RTType = "CHANNEL"
 )